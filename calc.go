@@ -0,0 +1,525 @@
+package csscolorparser
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// A small evaluator for CSS math functions: calc(), min(), max(), clamp(),
+// round() and friends.
+// https://www.w3.org/TR/css-values-4/#math
+
+type calcUnit int
+
+const (
+	unitNumber calcUnit = iota
+	unitPercent
+	unitAngle // in degrees
+)
+
+func (u calcUnit) String() string {
+	switch u {
+	case unitPercent:
+		return "<percentage>"
+	case unitAngle:
+		return "<angle>"
+	}
+	return "<number>"
+}
+
+type calcValue struct {
+	v    float64
+	unit calcUnit
+}
+
+var calcConstants = map[string]float64{
+	"pi":       math.Pi,
+	"e":        math.E,
+	"infinity": math.Inf(1),
+	"nan":      math.NaN(),
+}
+
+// maxCalcDepth limits the nesting of parentheses and math functions.
+const maxCalcDepth = 32
+
+var mathFunctions = map[string]bool{
+	"calc":  true,
+	"min":   true,
+	"max":   true,
+	"clamp": true,
+	"round": true,
+	"mod":   true,
+	"rem":   true,
+	"abs":   true,
+	"sign":  true,
+	"sin":   true,
+	"cos":   true,
+	"tan":   true,
+	"asin":  true,
+	"acos":  true,
+	"atan":  true,
+	"atan2": true,
+	"pow":   true,
+	"sqrt":  true,
+	"hypot": true,
+	"log":   true,
+	"exp":   true,
+}
+
+// isMathFunction reports whether s is a call to a math function.
+func isMathFunction(s string) bool {
+	op := strings.Index(s, "(")
+	return op > 0 && strings.HasSuffix(s, ")") && mathFunctions[s[:op]]
+}
+
+type calcParser struct {
+	s     string
+	pos   int
+	vars  map[string]float64
+	depth int
+}
+
+// evalMath evaluates s, which must be a math function. Identifiers other
+// than the constants pi, e, infinity and NaN are looked up in vars, as
+// numbers. -infinity is the negation of infinity.
+func evalMath(s string, vars map[string]float64) (calcValue, error) {
+	p := &calcParser{s: s, vars: vars}
+	v, err := p.primary()
+	if err != nil {
+		return v, err
+	}
+	if p.peek() != 0 {
+		return v, p.errorf(ErrBadMath, p.pos, p.s[p.pos:], "unexpected token")
+	}
+	// NaN results are censored to 0, infinite ones are clamped to the
+	// allowed range later.
+	if math.IsNaN(v.v) {
+		v.v = 0
+	}
+	if math.IsInf(v.v, 0) {
+		v.v = math.Copysign(math.MaxFloat64, v.v)
+	}
+	return v, nil
+}
+
+func (p *calcParser) errorf(kind error, offset int, token string, format string, a ...interface{}) error {
+	return &ParseError{
+		Kind:   kind,
+		Input:  p.s,
+		Offset: offset,
+		Token:  token,
+		Detail: fmt.Sprintf(format, a...),
+	}
+}
+
+func (p *calcParser) peek() byte {
+	for p.pos < len(p.s) && isSpace(p.s[p.pos]) {
+		p.pos++
+	}
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+func (p *calcParser) expect(c byte) error {
+	if p.peek() != c {
+		return p.errorf(ErrBadMath, p.pos, p.s[p.pos:], "expected '%c'", c)
+	}
+	p.pos++
+	return nil
+}
+
+// sum = product (('+' | '-') product)*
+func (p *calcParser) sum() (calcValue, error) {
+	p.peek()
+	start := p.pos
+	v, err := p.product()
+	for err == nil {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			break
+		}
+		p.pos++
+		var w calcValue
+		if w, err = p.product(); err != nil {
+			break
+		}
+		if v.unit != w.unit {
+			return v, p.errorf(ErrBadUnit, start, p.s[start:p.pos], "cannot add %s and %s", v.unit, w.unit)
+		}
+		if op == '+' {
+			v.v += w.v
+		} else {
+			v.v -= w.v
+		}
+	}
+	return v, err
+}
+
+// product = unary (('*' | '/') unary)*
+func (p *calcParser) product() (calcValue, error) {
+	p.peek()
+	start := p.pos
+	v, err := p.unary()
+	for err == nil {
+		op := p.peek()
+		if op != '*' && op != '/' {
+			break
+		}
+		p.pos++
+		var w calcValue
+		if w, err = p.unary(); err != nil {
+			break
+		}
+		if op == '*' {
+			if v.unit != unitNumber && w.unit != unitNumber {
+				return v, p.errorf(ErrBadUnit, start, p.s[start:p.pos], "cannot multiply %s by %s", v.unit, w.unit)
+			}
+			if v.unit == unitNumber {
+				v.unit = w.unit
+			}
+			v.v *= w.v
+		} else {
+			if w.unit != unitNumber {
+				return v, p.errorf(ErrBadUnit, start, p.s[start:p.pos], "cannot divide by %s", w.unit)
+			}
+			v.v /= w.v
+		}
+	}
+	return v, err
+}
+
+// unary = ('+' | '-')? primary
+func (p *calcParser) unary() (calcValue, error) {
+	switch p.peek() {
+	case '-':
+		p.pos++
+		v, err := p.primary()
+		v.v = -v.v
+		return v, err
+	case '+':
+		p.pos++
+	}
+	return p.primary()
+}
+
+// nest enters a parenthesized expression or function at start.
+func (p *calcParser) nest(start int, token string) error {
+	if p.depth++; p.depth > maxCalcDepth {
+		return p.errorf(ErrTooComplex, start, token, "more than %d nested math functions", maxCalcDepth)
+	}
+	return nil
+}
+
+func isIdentChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_'
+}
+
+// primary = dimension | identifier | '(' sum ')' | function '(' args ')'
+func (p *calcParser) primary() (calcValue, error) {
+	c := p.peek()
+	start := p.pos
+
+	if c == '(' {
+		if err := p.nest(start, "("); err != nil {
+			return calcValue{}, err
+		}
+		defer func() { p.depth-- }()
+		p.pos++
+		v, err := p.sum()
+		if err != nil {
+			return v, err
+		}
+		return v, p.expect(')')
+	}
+
+	if c >= 'a' && c <= 'z' {
+		for p.pos < len(p.s) && isIdentChar(p.s[p.pos]) {
+			p.pos++
+		}
+		name := p.s[start:p.pos]
+		if p.pos < len(p.s) && p.s[p.pos] == '(' {
+			if err := p.nest(start, name); err != nil {
+				return calcValue{}, err
+			}
+			defer func() { p.depth-- }()
+			p.pos++
+			return p.function(name, start)
+		}
+		if v, ok := calcConstants[name]; ok {
+			return calcValue{v, unitNumber}, nil
+		}
+		if v, ok := p.vars[name]; ok {
+			return calcValue{v, unitNumber}, nil
+		}
+		return calcValue{}, p.errorf(ErrBadMath, start, name, "unknown identifier")
+	}
+
+	p.pos += scanNumber(p.s[p.pos:], false)
+	if p.pos == start {
+		if p.pos == len(p.s) {
+			return calcValue{}, p.errorf(ErrBadMath, p.pos, "", "unexpected end")
+		}
+		return calcValue{}, p.errorf(ErrBadMath, p.pos, p.s[p.pos:], "unexpected token")
+	}
+	f := numberValue(p.s[start:p.pos])
+
+	unitStart := p.pos
+	if p.pos < len(p.s) && p.s[p.pos] == '%' {
+		p.pos++
+		return calcValue{f, unitPercent}, nil
+	}
+	for p.pos < len(p.s) && p.s[p.pos] >= 'a' && p.s[p.pos] <= 'z' {
+		p.pos++
+	}
+	switch unit := p.s[unitStart:p.pos]; unit {
+	case "":
+		return calcValue{f, unitNumber}, nil
+	case "deg":
+		return calcValue{f, unitAngle}, nil
+	case "grad":
+		return calcValue{f / 400 * 360, unitAngle}, nil
+	case "rad":
+		return calcValue{f / math.Pi * 180, unitAngle}, nil
+	case "turn":
+		return calcValue{f * 360, unitAngle}, nil
+	default:
+		return calcValue{}, p.errorf(ErrBadUnit, start, p.s[start:p.pos], "")
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// args parses the comma separated arguments of a function, up to and
+// including the closing parenthesis.
+func (p *calcParser) args() ([]calcValue, error) {
+	var args []calcValue
+	for {
+		v, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return args, nil
+		default:
+			return nil, p.errorf(ErrBadMath, p.pos, p.s[p.pos:], "expected ',' or ')'")
+		}
+	}
+}
+
+type roundingStrategy int
+
+const (
+	roundNearest roundingStrategy = iota
+	roundUp
+	roundDown
+	roundToZero
+)
+
+var roundingStrategies = map[string]roundingStrategy{
+	"nearest": roundNearest,
+	"up":      roundUp,
+	"down":    roundDown,
+	"to-zero": roundToZero,
+}
+
+func (p *calcParser) function(name string, start int) (calcValue, error) {
+	if !mathFunctions[name] {
+		return calcValue{}, p.errorf(ErrUnknownFunction, start, name, "")
+	}
+	strategy := roundNearest
+	if name == "round" {
+		p.peek()
+		start := p.pos
+		for p.pos < len(p.s) && isIdentChar(p.s[p.pos]) {
+			p.pos++
+		}
+		if v, ok := roundingStrategies[p.s[start:p.pos]]; ok && p.peek() == ',' {
+			strategy = v
+			p.pos++
+		} else {
+			p.pos = start
+		}
+	}
+
+	args, err := p.args()
+	if err != nil {
+		return calcValue{}, err
+	}
+
+	arity := func(min, max int) error {
+		if len(args) < min || (max != -1 && len(args) > max) {
+			return p.errorf(ErrBadMath, start, p.s[start:p.pos], "wrong number of arguments")
+		}
+		return nil
+	}
+	// All arguments must have the same type.
+	sameType := func() error {
+		for _, a := range args[1:] {
+			if a.unit != args[0].unit {
+				return p.errorf(ErrBadUnit, start, p.s[start:p.pos], "arguments %s and %s do not match", args[0].unit, a.unit)
+			}
+		}
+		return nil
+	}
+	numbers := func() error {
+		for _, a := range args {
+			if a.unit != unitNumber {
+				return p.errorf(ErrBadUnit, start, p.s[start:p.pos], "expected <number>, got %s", a.unit)
+			}
+		}
+		return nil
+	}
+	check := func(errs ...error) error {
+		for _, e := range errs {
+			if e != nil {
+				return e
+			}
+		}
+		return nil
+	}
+	toRadians := func(a calcValue) float64 {
+		if a.unit == unitAngle {
+			return a.v * math.Pi / 180
+		}
+		return a.v
+	}
+	angle := func(rad float64) calcValue {
+		return calcValue{rad * 180 / math.Pi, unitAngle}
+	}
+
+	switch name {
+	case "calc":
+		if err := arity(1, 1); err != nil {
+			return calcValue{}, err
+		}
+		return args[0], nil
+	case "min", "max":
+		if err := check(arity(1, -1), sameType()); err != nil {
+			return calcValue{}, err
+		}
+		v := args[0]
+		for _, a := range args[1:] {
+			if math.IsNaN(a.v) || (name == "min" && a.v < v.v) || (name == "max" && a.v > v.v) {
+				v = a
+			}
+		}
+		return v, nil
+	case "clamp":
+		if err := check(arity(3, 3), sameType()); err != nil {
+			return calcValue{}, err
+		}
+		v := args[1]
+		v.v = math.Max(args[0].v, math.Min(v.v, args[2].v))
+		return v, nil
+	case "round":
+		if len(args) == 1 && args[0].unit == unitNumber {
+			args = append(args, calcValue{1, unitNumber})
+		}
+		if err := check(arity(2, 2), sameType()); err != nil {
+			return calcValue{}, err
+		}
+		v, step := args[0], args[1].v
+		x := v.v / step
+		switch strategy {
+		case roundNearest:
+			x = math.Floor(x + 0.5)
+		case roundUp:
+			x = math.Ceil(x)
+		case roundDown:
+			x = math.Floor(x)
+		case roundToZero:
+			x = math.Trunc(x)
+		}
+		v.v = x * step
+		return v, nil
+	case "mod", "rem":
+		if err := check(arity(2, 2), sameType()); err != nil {
+			return calcValue{}, err
+		}
+		v := args[0]
+		if name == "mod" {
+			v.v = v.v - args[1].v*math.Floor(v.v/args[1].v)
+		} else {
+			v.v = math.Mod(v.v, args[1].v)
+		}
+		return v, nil
+	case "abs":
+		if err := arity(1, 1); err != nil {
+			return calcValue{}, err
+		}
+		v := args[0]
+		v.v = math.Abs(v.v)
+		return v, nil
+	case "sign":
+		if err := arity(1, 1); err != nil {
+			return calcValue{}, err
+		}
+		v := 0.0
+		if args[0].v > 0 {
+			v = 1
+		} else if args[0].v < 0 {
+			v = -1
+		}
+		return calcValue{v, unitNumber}, nil
+	case "sin", "cos", "tan":
+		if err := arity(1, 1); err != nil {
+			return calcValue{}, err
+		}
+		if args[0].unit == unitPercent {
+			return calcValue{}, p.errorf(ErrBadUnit, start, p.s[start:p.pos], "expected <number> or <angle>, got %s", args[0].unit)
+		}
+		f := map[string]func(float64) float64{"sin": math.Sin, "cos": math.Cos, "tan": math.Tan}[name]
+		return calcValue{f(toRadians(args[0])), unitNumber}, nil
+	case "asin", "acos", "atan":
+		if err := check(arity(1, 1), numbers()); err != nil {
+			return calcValue{}, err
+		}
+		f := map[string]func(float64) float64{"asin": math.Asin, "acos": math.Acos, "atan": math.Atan}[name]
+		return angle(f(args[0].v)), nil
+	case "atan2":
+		if err := check(arity(2, 2), sameType()); err != nil {
+			return calcValue{}, err
+		}
+		return angle(math.Atan2(args[0].v, args[1].v)), nil
+	case "pow":
+		if err := check(arity(2, 2), numbers()); err != nil {
+			return calcValue{}, err
+		}
+		return calcValue{math.Pow(args[0].v, args[1].v), unitNumber}, nil
+	case "sqrt", "exp":
+		if err := check(arity(1, 1), numbers()); err != nil {
+			return calcValue{}, err
+		}
+		f := map[string]func(float64) float64{"sqrt": math.Sqrt, "exp": math.Exp}[name]
+		return calcValue{f(args[0].v), unitNumber}, nil
+	case "log":
+		if err := check(arity(1, 2), numbers()); err != nil {
+			return calcValue{}, err
+		}
+		v := math.Log(args[0].v)
+		if len(args) == 2 {
+			v /= math.Log(args[1].v)
+		}
+		return calcValue{v, unitNumber}, nil
+	case "hypot":
+		if err := check(arity(1, -1), sameType()); err != nil {
+			return calcValue{}, err
+		}
+		v := calcValue{0, args[0].unit}
+		for _, a := range args {
+			v.v = math.Hypot(v.v, a.v)
+		}
+		return v, nil
+	}
+	return calcValue{}, p.errorf(ErrUnknownFunction, start, name, "")
+}
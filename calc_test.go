@@ -0,0 +1,110 @@
+package csscolorparser
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func Test_Calc(t *testing.T) {
+	vars := map[string]float64{"r": 255, "alpha": 0.5}
+	data := []struct {
+		s    string
+		f    float64
+		unit calcUnit
+	}{
+		{"calc(1)", 1, unitNumber},
+		{"calc(1 + 2 * 3)", 7, unitNumber},
+		{"calc((1 + 2) * 3)", 9, unitNumber},
+		{"calc(-r / 5)", -51, unitNumber},
+		{"calc(r - -5)", 260, unitNumber},
+		{"calc(alpha * 2)", 1, unitNumber},
+		{"calc(1e2 + .5)", 100.5, unitNumber},
+		{"calc(calc(1 + 1) * 2)", 4, unitNumber},
+		{"calc(50% / 2)", 25, unitPercent},
+		{"calc(2 * 10% + 5%)", 25, unitPercent},
+		{"calc(120deg + 30deg)", 150, unitAngle},
+		{"calc(0.5turn - 100grad)", 90, unitAngle},
+		{"calc(pi * 1rad)", 180, unitAngle},
+		{"calc(e)", math.E, unitNumber},
+		{"min(1, 2, -3)", -3, unitNumber},
+		{"max(10%, 20%)", 20, unitPercent},
+		{"clamp(0, 1.5, 1)", 1, unitNumber},
+		{"clamp(0deg, -20deg, 90deg)", 0, unitAngle},
+		{"round(2.5)", 3, unitNumber},
+		{"round(-2.5)", -2, unitNumber},
+		{"round(down, 2.7, 1)", 2, unitNumber},
+		{"round(up, 21deg, 10deg)", 30, unitAngle},
+		{"round(to-zero, -2.7, 1)", -2, unitNumber},
+		{"round(nearest, 33%, 5%)", 35, unitPercent},
+		{"mod(-7, 3)", 2, unitNumber},
+		{"rem(-7, 3)", -1, unitNumber},
+		{"abs(-20%)", 20, unitPercent},
+		{"sign(-20deg)", -1, unitNumber},
+		{"sin(90deg)", 1, unitNumber},
+		{"cos(0)", 1, unitNumber},
+		{"atan2(1, 1)", 45, unitAngle},
+		{"acos(1)", 0, unitAngle},
+		{"pow(2, 10)", 1024, unitNumber},
+		{"sqrt(16)", 4, unitNumber},
+		{"hypot(3, 4)", 5, unitNumber},
+		{"log(8, 2)", 3, unitNumber},
+		{"exp(0)", 1, unitNumber},
+		{"calc(1 / 0)", math.MaxFloat64, unitNumber},
+		{"calc(infinity)", math.MaxFloat64, unitNumber},
+		{"calc(-infinity * 1deg)", -math.MaxFloat64, unitAngle},
+		{"calc(nan)", 0, unitNumber},
+		{"calc(0 / 0)", 0, unitNumber},
+		{"calc(1 + min(nan, 1))", 0, unitNumber},
+		{"max(1%, nan * 1%)", 0, unitPercent},
+	}
+	for _, d := range data {
+		v, err := evalMath(d.s, vars)
+		test(t, err, nil)
+		testTrue(t, math.Abs(v.v-d.f) < 1e-9)
+		test(t, v.unit, d.unit)
+	}
+
+	invalid := []string{
+		"calc()",
+		"calc(1 +)",
+		"calc(1 2)",
+		"calc(g)",
+		"calc((1)",
+		"calc(1, 2)",
+		"calc(10% + 5)",
+		"calc(10deg + 5%)",
+		"calc(10deg * 5deg)",
+		"calc(10 / 5%)",
+		"calc(5px)",
+		"min(1, 2%)",
+		"clamp(1, 2)",
+		"round(1%)",
+		"round(sideways, 1, 1)",
+		"sin(10%)",
+		"asin(1deg)",
+		"pow(2deg, 2)",
+		"foo(1)",
+	}
+	for _, s := range invalid {
+		_, err := evalMath(s, vars)
+		testTrue(t, err != nil)
+	}
+
+	// Nesting is limited
+	deep := strings.Repeat("calc(", maxCalcDepth) + "1" + strings.Repeat(")", maxCalcDepth)
+	_, err := evalMath(deep, nil)
+	test(t, err, nil)
+	_, err = evalMath("calc("+deep+")", nil)
+	testTrue(t, errors.Is(err, ErrTooComplex))
+	_, err = evalMath("calc"+strings.Repeat("(", maxCalcDepth+1)+"1"+strings.Repeat(")", maxCalcDepth+1), nil)
+	testTrue(t, errors.Is(err, ErrTooComplex))
+	n := 100000
+	_, err = Parse("rgb(" + strings.Repeat("calc(", n) + "1" + strings.Repeat(")", n) + " 0 0)")
+	testTrue(t, errors.Is(err, ErrTooComplex))
+
+	c, err := Parse("rgb(calc(infinity) calc(-infinity) calc(NaN))")
+	test(t, err, nil)
+	test(t, c.HexString(), "#ff0000")
+}
@@ -0,0 +1,160 @@
+package csscolorparser
+
+import "math"
+
+// Device CMYK
+// https://www.w3.org/TR/css-color-5/#device-cmyk
+
+// FromCMYK creates a Color from device CMYK colors, using the naive
+// conversion of CSS Color 5.
+//
+// Arguments:
+//
+//   - c: Cyan [0..1]
+//   - m: Magenta [0..1]
+//   - y: Yellow [0..1]
+//   - k: Black [0..1]
+//   - a: Alpha [0..1]
+func FromCMYK(c, m, y, k, a float64) Color {
+	c, m, y, k = clamp0_1(c), clamp0_1(m), clamp0_1(y), clamp0_1(k)
+	return Color{
+		1 - math.Min(1, c*(1-k)+k),
+		1 - math.Min(1, m*(1-k)+k),
+		1 - math.Min(1, y*(1-k)+k),
+		clamp0_1(a),
+	}
+}
+
+// CMYKOptions control the black generation and undercolor removal of
+// Color.ToCMYK. Both functions take the gray component of the color,
+// min(1-R, 1-G, 1-B), and return a value in 0..1.
+type CMYKOptions struct {
+	// BlackGeneration returns the black (K) component.
+	// If nil, K is the gray component.
+	BlackGeneration func(gray float64) float64
+
+	// UnderColorRemoval returns the amount removed from C, M and Y.
+	// If nil, it is the same as K.
+	UnderColorRemoval func(gray float64) float64
+}
+
+// ToCMYK converts the color to device CMYK, ignoring alpha. With nil
+// options, it is the inverse of the naive conversion used by FromCMYK.
+func (col Color) ToCMYK(opts *CMYKOptions) (c, m, y, k float64) {
+	col = col.Clamp()
+	c, m, y = 1-col.R, 1-col.G, 1-col.B
+	gray := math.Min(c, math.Min(m, y))
+
+	k = gray
+	if opts != nil && opts.BlackGeneration != nil {
+		k = clamp0_1(opts.BlackGeneration(gray))
+	}
+	ucr := k
+	if opts != nil && opts.UnderColorRemoval != nil {
+		ucr = clamp0_1(opts.UnderColorRemoval(gray))
+	}
+
+	if k >= 1 {
+		return 0, 0, 0, 1
+	}
+	if ucr >= 1 {
+		return 0, 0, 0, k
+	}
+	// The undercolor is removed from C, M and Y, which are then rescaled
+	// to the remaining range, like the naive conversion does with K.
+	f := func(v float64) float64 {
+		return clamp0_1((v - ucr) / (1 - ucr))
+	}
+	return f(c), f(m), f(y), k
+}
+
+// device-cmyk(<cmyk-component>{4} [/ <alpha-value>]? [, <color>]?)
+// device-cmyk(<number>#{4})
+func (p *parser) deviceCMYK(s string, offset int) (Color, Missing, error) {
+	parts, partOffsets := splitComma(s)
+
+	var (
+		params   []string
+		offsets  []int
+		fallback = -1
+		legacy   = len(parts) >= 4
+	)
+	if legacy {
+		if p.strict() {
+			if len(parts) != 4 {
+				return black, 0, p.failf(ErrWrongArity, offset, s, "expected 4 components, got %d", len(parts))
+			}
+			for i, part := range parts {
+				if f, _ := splitParams(part); len(f) != 1 {
+					return black, 0, p.failf(ErrInvalidFormat, offset+partOffsets[i], part, "expected a comma")
+				}
+			}
+		}
+		params, offsets = splitParams(s)
+	} else {
+		if len(parts) == 2 {
+			fallback = 1
+		} else if len(parts) != 1 {
+			return black, 0, p.fail(ErrInvalidFormat, offset, s)
+		}
+		if p.strict() {
+			if _, err := p.checkSeparators("device-cmyk", parts[0], offset+partOffsets[0]); err != nil {
+				return black, 0, err
+			}
+		}
+		params, offsets = splitParams(parts[0])
+		for i := range offsets {
+			offsets[i] += partOffsets[0]
+		}
+	}
+	for i := range offsets {
+		offsets[i] += offset
+	}
+
+	if len(params) > 0 && params[0] == "from" {
+		return black, 0, p.failf(ErrInvalidFormat, offsets[0], params[0], "relative colors are not supported by device-cmyk()")
+	}
+	if len(params) != 4 && (len(params) != 5 || (legacy && p.strict())) {
+		return black, 0, p.failf(ErrWrongArity, offset, s, "expected 4 components and an optional alpha, got %d", len(params))
+	}
+
+	var missing Missing
+	var v [5]float64
+	v[4] = 1
+	for i, param := range params {
+		if param == "none" && !legacy {
+			if i == 4 {
+				missing |= MissingAlpha
+				v[4] = 0
+			}
+			continue
+		}
+		if err := p.checkComponent(param, offsets[i], false); err != nil {
+			return black, 0, err
+		}
+		x, ok, _ := parsePercentOrFloat(param)
+		if !ok {
+			return black, 0, p.fail(ErrBadNumber, offsets[i], param)
+		}
+		v[i] = clamp0_1(x)
+	}
+
+	var fc Color
+	var fm Missing
+	if fallback != -1 {
+		var err error
+		if fc, fm, err = p.parseColor(parts[fallback], offset+partOffsets[fallback]); err != nil {
+			return black, 0, err
+		}
+	}
+
+	switch {
+	case p.ctx.CMYK != nil:
+		c := p.ctx.CMYK(v[0], v[1], v[2], v[3])
+		c.A = v[4]
+		return c, missing, nil
+	case fallback != -1:
+		return fc, fm, nil
+	}
+	return FromCMYK(v[0], v[1], v[2], v[3], v[4]), missing, nil
+}
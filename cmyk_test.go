@@ -0,0 +1,146 @@
+package csscolorparser
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func Test_CMYK(t *testing.T) {
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"device-cmyk(0 0 0 0)", "#ffffff"},
+		{"device-cmyk(0 0 0 1)", "#000000"},
+		{"device-cmyk(0 1 1 0)", "#ff0000"},
+		{"device-cmyk(0% 100% 100% 0%)", "#ff0000"},
+		{"device-cmyk(0.1 0.2 0.3 0.4)", "#8a7a6b"},
+		{"device-cmyk(0.1 0.2 0.3 0.4 / 50%)", "#8a7a6b80"},
+		{"device-cmyk(0.1 0.2 0.3 0.4 / none)", "#8a7a6b00"},
+		{"device-cmyk(none 1 1 none)", "#ff0000"},
+		{"device-cmyk(-1 2 200% 0)", "#ff0000"},
+		{"device-cmyk(0.1, 0.2, 0.3, 0.4)", "#8a7a6b"},
+		{"device-cmyk(calc(0.5 + 0.5) 0 0 0)", "#00ffff"},
+		{"device-cmyk(0 0.81 0.81 0.3, rgb(178 34 34))", "#b22222"},
+		{"DEVICE-CMYK(0 0 0 0, lime)", "#00ff00"},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+	}
+
+	_, m, err := ParseMissing("device-cmyk(0 0 0 0 / none)")
+	test(t, err, nil)
+	test(t, m, MissingAlpha)
+
+	invalid := []struct {
+		s    string
+		kind error
+	}{
+		{"device-cmyk(0 0 0)", ErrWrongArity},
+		{"device-cmyk(0 0 0 0 0 0)", ErrWrongArity},
+		{"device-cmyk(0 0 0 1deg)", ErrBadUnit},
+		{"device-cmyk(0 0 0 x)", ErrBadNumber},
+		{"device-cmyk(0 0 0 0, bloodred)", ErrUnknownName},
+		{"device-cmyk(0 0 0 0, red, blue)", ErrInvalidFormat},
+		{"device-cmyk(from red c m y k)", ErrInvalidFormat},
+	}
+	for _, d := range invalid {
+		c, err := Parse(d.s)
+		testColor(t, c, Color{A: 1})
+		if !errors.Is(err, d.kind) {
+			t.Errorf("%q: expected %v, got %v", d.s, d.kind, err)
+		}
+	}
+
+	strict := &ParseContext{Strict: true}
+	for _, s := range []string{"device-cmyk(0 0 0 0 / 1)", "device-cmyk(0, 0, 0, 0)", "device-cmyk(0 0 0 0, red)"} {
+		_, err := ParseWithContext(s, strict)
+		test(t, err, nil)
+	}
+	for _, s := range []string{"device-cmyk(0 0 0 0 1)", "device-cmyk(0, 0, 0, 0, 1)", "device-cmyk(0 0, 0, 0 0)", "device-cmyk(0 0 0 / 0 0)"} {
+		_, err := ParseWithContext(s, strict)
+		testTrue(t, err != nil)
+	}
+
+	// Custom converter
+	ctx := &ParseContext{CMYK: func(c, m, y, k float64) Color {
+		return Color{1 - c, 1 - m, 1 - y, 1}
+	}}
+	c, err := ParseWithContext("device-cmyk(1 0 0 0.5 / 0.5, red)", ctx)
+	test(t, err, nil)
+	test(t, c, Color{0, 1, 1, 0.5})
+
+	// The fallback color wins over the naive conversion
+	c, err = Parse("device-cmyk(0 0 0 0 / 50%, red)")
+	test(t, err, nil)
+	test(t, c.HexString(), "#ff0000")
+	ctx = &ParseContext{CMYK: func(c, m, y, k float64) Color {
+		return FromCMYK(c, m, y, k, 1)
+	}}
+	c, err = ParseWithContext("device-cmyk(0 0 0 0 / 50%, red)", ctx)
+	test(t, err, nil)
+	test(t, c.HexString(), "#ffffff80")
+}
+
+func Test_ToCMYK(t *testing.T) {
+	near := func(a, b [4]float64) bool {
+		for i := range a {
+			if math.Abs(a[i]-b[i]) > 1e-9 {
+				return false
+			}
+		}
+		return true
+	}
+	cmyk := func(col Color, opts *CMYKOptions) [4]float64 {
+		c, m, y, k := col.ToCMYK(opts)
+		return [4]float64{c, m, y, k}
+	}
+
+	test(t, cmyk(Color{1, 1, 1, 1}, nil), [4]float64{0, 0, 0, 0})
+	test(t, cmyk(Color{0, 0, 0, 1}, nil), [4]float64{0, 0, 0, 1})
+	test(t, cmyk(Color{1, 0, 0, 1}, nil), [4]float64{0, 1, 1, 0})
+
+	// Round trip with the naive conversion, when one of C, M, Y is zero
+	for _, v := range [][4]float64{{0, 0.2, 0.3, 0.4}, {0, 0.5, 1, 0}, {0.7, 0, 0.25, 0.9}} {
+		col := FromCMYK(v[0], v[1], v[2], v[3], 1)
+		testTrue(t, near(cmyk(col, nil), v))
+	}
+
+	gray := Color{0.4, 0.4, 0.4, 1}
+	testTrue(t, near(cmyk(gray, nil), [4]float64{0, 0, 0, 0.6}))
+
+	// No black generation
+	noBlack := &CMYKOptions{BlackGeneration: func(float64) float64 { return 0 }}
+	testTrue(t, near(cmyk(gray, noBlack), [4]float64{0.6, 0.6, 0.6, 0}))
+
+	// Half black, without undercolor removal
+	half := &CMYKOptions{
+		BlackGeneration:   func(g float64) float64 { return g / 2 },
+		UnderColorRemoval: func(float64) float64 { return 0 },
+	}
+	testTrue(t, near(cmyk(gray, half), [4]float64{0.6, 0.6, 0.6, 0.3}))
+
+	// Half black, with as much undercolor removal
+	halfUCR := &CMYKOptions{BlackGeneration: func(g float64) float64 { return g / 2 }}
+	testTrue(t, near(cmyk(gray, halfUCR), [4]float64{0.3 / 0.7, 0.3 / 0.7, 0.3 / 0.7, 0.3}))
+	c, m, y, k := gray.ToCMYK(halfUCR)
+	testColor(t, FromCMYK(c, m, y, k, 1), gray)
+
+	// Removing less undercolor than the black added darkens the color
+	quarterUCR := &CMYKOptions{
+		BlackGeneration:   func(g float64) float64 { return g / 2 },
+		UnderColorRemoval: func(g float64) float64 { return g / 4 },
+	}
+	for _, opts := range []*CMYKOptions{half, quarterUCR} {
+		c, m, y, k = gray.ToCMYK(opts)
+		testTrue(t, FromCMYK(c, m, y, k, 1).R < gray.R)
+	}
+	fullUCR := &CMYKOptions{
+		BlackGeneration:   func(g float64) float64 { return g / 2 },
+		UnderColorRemoval: func(float64) float64 { return 1 },
+	}
+	testTrue(t, near(cmyk(gray, fullUCR), [4]float64{0, 0, 0, 0.3}))
+}
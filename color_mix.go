@@ -0,0 +1,337 @@
+package csscolorparser
+
+import (
+	"math"
+	"strings"
+)
+
+// color-mix()
+// https://www.w3.org/TR/css-color-5/#color-mix
+
+// Interpolation color spaces, with the index of the hue channel
+// for polar color spaces, or -1.
+var mixSpaces = map[string]int{
+	"srgb":         -1,
+	"srgb-linear":  -1,
+	"display-p3":   -1,
+	"a98-rgb":      -1,
+	"prophoto-rgb": -1,
+	"rec2020":      -1,
+	"lab":          -1,
+	"oklab":        -1,
+	"xyz":          -1,
+	"xyz-d50":      -1,
+	"xyz-d65":      -1,
+	"hsl":          0,
+	"hwb":          0,
+	"lch":          2,
+	"oklch":        2,
+}
+
+// Analogous components, used to carry missing components forward
+// into the interpolation color space.
+// https://www.w3.org/TR/css-color-4/#interpolation-missing
+var analogousComponents = map[string][3]string{
+	"rgb":   {"red", "green", "blue"},
+	"rgba":  {"red", "green", "blue"},
+	"hsl":   {"hue", "colorfulness", "lightness"},
+	"hsla":  {"hue", "colorfulness", "lightness"},
+	"hwb":   {"hue", "", ""},
+	"hwba":  {"hue", "", ""},
+	"hsv":   {"hue", "colorfulness", ""},
+	"hsva":  {"hue", "colorfulness", ""},
+	"lab":   {"lightness", "opponent-a", "opponent-b"},
+	"oklab": {"lightness", "opponent-a", "opponent-b"},
+	"lch":   {"lightness", "colorfulness", "hue"},
+	"oklch": {"lightness", "colorfulness", "hue"},
+}
+
+func componentsOf(space string) [3]string {
+	if v, ok := analogousComponents[space]; ok {
+		return v
+	}
+	// Predefined color spaces, including xyz
+	return [3]string{"red", "green", "blue"}
+}
+
+// Hue interpolation methods
+type hueInterpolation int
+
+const (
+	hueShorter hueInterpolation = iota
+	hueLonger
+	hueIncreasing
+	hueDecreasing
+)
+
+var hueMethods = map[string]hueInterpolation{
+	"shorter":    hueShorter,
+	"longer":     hueLonger,
+	"increasing": hueIncreasing,
+	"decreasing": hueDecreasing,
+}
+
+type mixColor struct {
+	v       [3]float64
+	alpha   float64
+	missing Missing
+}
+
+// toMixColor converts a parsed color to the interpolation color space, carrying
+// its missing components forward and marking powerless hues as missing.
+func toMixColor(space, src string, c Color, missing Missing) (m mixColor) {
+	m.v, _ = toChannels(space, c)
+	m.alpha = c.A
+
+	if missing&MissingAlpha != 0 {
+		m.missing |= MissingAlpha
+	}
+	from := componentsOf(src)
+	to := componentsOf(space)
+	for i := range from {
+		if missing&(Missing0<<uint(i)) == 0 || from[i] == "" {
+			continue
+		}
+		for j := range to {
+			if to[j] == from[i] {
+				m.missing |= Missing0 << uint(j)
+			}
+		}
+	}
+
+	// Powerless hue
+	switch space {
+	case "hsl":
+		if math.Abs(m.v[1]) < 1e-9 {
+			m.missing |= Missing0
+		}
+	case "hwb":
+		if m.v[1]+m.v[2] >= 100-1e-9 {
+			m.missing |= Missing0
+		}
+	case "lch", "oklch":
+		if m.v[1] < 1e-6 {
+			m.missing |= Missing2
+		}
+	}
+	return
+}
+
+// functionName returns the color function name of a color string, if any.
+func functionName(s string) string {
+	s = strings.TrimSpace(strings.ToLower(s))
+	op := strings.Index(s, "(")
+	if op == -1 {
+		return ""
+	}
+	name := strings.TrimSpace(s[:op])
+	if name == "color" {
+		// color(from <color> <space> ...) is not analysed further
+		params, _ := splitParams(s[op+1:])
+		if len(params) > 0 && params[0] != "from" {
+			return params[0]
+		}
+	}
+	return name
+}
+
+// mixColors interpolates two colors in the interpolation color space.
+// p is the weight of the second color.
+func mixColors(space string, hue hueInterpolation, c1, c2 mixColor, p float64) (v [3]float64, alpha float64, missing Missing) {
+	hueIdx := mixSpaces[space]
+
+	// Missing components take the value from the other color.
+	carry := func(m Missing, a, b *float64) {
+		switch {
+		case c1.missing&m != 0 && c2.missing&m != 0:
+			missing |= m
+			*a, *b = 0, 0
+		case c1.missing&m != 0:
+			*a = *b
+		case c2.missing&m != 0:
+			*b = *a
+		}
+	}
+
+	a1, a2 := c1.alpha, c2.alpha
+	carry(MissingAlpha, &a1, &a2)
+	premultiply := missing&MissingAlpha == 0
+	alpha = a1 + (a2-a1)*p
+
+	for i := 0; i < 3; i++ {
+		x, y := c1.v[i], c2.v[i]
+		carry(Missing0<<uint(i), &x, &y)
+
+		if i == hueIdx {
+			x, y = fixupHues(normalizeAngle(x), normalizeAngle(y), hue)
+			v[i] = normalizeAngle(x + (y-x)*p)
+			continue
+		}
+		if premultiply {
+			x *= a1
+			y *= a2
+		}
+		v[i] = x + (y-x)*p
+		if premultiply && alpha != 0 {
+			v[i] /= alpha
+		}
+	}
+	return
+}
+
+// https://www.w3.org/TR/css-color-4/#hue-interpolation
+func fixupHues(h1, h2 float64, method hueInterpolation) (float64, float64) {
+	d := h2 - h1
+	switch method {
+	case hueShorter:
+		if d > 180 {
+			h1 += 360
+		} else if d < -180 {
+			h2 += 360
+		}
+	case hueLonger:
+		if d > 0 && d < 180 {
+			h1 += 360
+		} else if d > -180 && d <= 0 {
+			h2 += 360
+		}
+	case hueIncreasing:
+		if d < 0 {
+			h2 += 360
+		}
+	case hueDecreasing:
+		if d > 0 {
+			h1 += 360
+		}
+	}
+	return h1, h2
+}
+
+// splitComma splits s on commas, except inside nested parentheses.
+// It also returns the offsets of the parts in s.
+func splitComma(s string) ([]string, []int) {
+	var res []string
+	var offsets []int
+	add := func(start, end int) {
+		part := s[start:end]
+		trimmed := strings.TrimSpace(part)
+		offsets = append(offsets, start+len(part)-len(strings.TrimLeft(part, " \t\n\r\f")))
+		res = append(res, trimmed)
+	}
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				add(start, i)
+				start = i + 1
+			}
+		}
+	}
+	add(start, len(s))
+	return res, offsets
+}
+
+// parseMixColor parses "<color> <percentage>?" in any order.
+// s starts at offset in p.input.
+func (p *parser) parseMixColor(s string, offset int) (c Color, src string, missing Missing, pct float64, hasPct bool, err error) {
+	parts, offsets := splitParams(s)
+	if len(parts) == 2 {
+		if _, ok, isPct := parsePercentOrFloat(parts[0]); ok && isPct {
+			parts[0], parts[1] = parts[1], parts[0]
+			offsets[0], offsets[1] = offsets[1], offsets[0]
+		}
+		v, ok, isPct := parsePercentOrFloat(parts[1])
+		if !ok || !isPct {
+			err = p.failf(ErrBadUnit, offset+offsets[1], parts[1], "expected a percentage")
+			return
+		}
+		pct = v * 100
+		if pct < 0 || pct > 100 {
+			err = p.failf(ErrOutOfRange, offset+offsets[1], parts[1], "percentage must be between 0%% and 100%%")
+			return
+		}
+		hasPct = true
+	} else if len(parts) != 1 {
+		err = p.failf(ErrWrongArity, offset, s, "expected a color and an optional percentage")
+		return
+	}
+	c, missing, err = p.parseColor(parts[0], offset+offsets[0])
+	if err != nil {
+		return
+	}
+	return c, functionName(parts[0]), missing, pct, hasPct, nil
+}
+
+// parseColorMix parses the arguments of color-mix(), which start at offset in p.input.
+func (p *parser) parseColorMix(s string, offset int) (Color, Missing, error) {
+	args, offsets := splitComma(s)
+	space := "oklab"
+	hue := hueShorter
+
+	if len(args) == 3 {
+		f := strings.Fields(args[0])
+		if len(f) < 2 || f[0] != "in" {
+			return black, 0, p.failf(ErrInvalidFormat, offset+offsets[0], args[0], "expected 'in <color space>'")
+		}
+		space = f[1]
+		if _, ok := mixSpaces[space]; !ok {
+			return black, 0, p.fail(ErrUnknownColorSpace, offset+offsets[0]+strings.Index(args[0], space), space)
+		}
+		if len(f) == 4 {
+			var ok bool
+			hue, ok = hueMethods[f[2]]
+			if !ok || f[3] != "hue" || mixSpaces[space] == -1 {
+				return black, 0, p.failf(ErrInvalidFormat, offset+offsets[0], args[0], "invalid hue interpolation method")
+			}
+		} else if len(f) != 2 {
+			return black, 0, p.fail(ErrInvalidFormat, offset+offsets[0], args[0])
+		}
+		args, offsets = args[1:], offsets[1:]
+	}
+	if len(args) != 2 {
+		return black, 0, p.failf(ErrWrongArity, offset, s, "color-mix() expects 2 colors, got %d", len(args))
+	}
+
+	c1, src1, m1, p1, ok1, err := p.parseMixColor(args[0], offset+offsets[0])
+	if err != nil {
+		return black, 0, err
+	}
+	c2, src2, m2, p2, ok2, err := p.parseMixColor(args[1], offset+offsets[1])
+	if err != nil {
+		return black, 0, err
+	}
+
+	// Percentage normalization
+	switch {
+	case !ok1 && !ok2:
+		p1, p2 = 50, 50
+	case !ok1:
+		p1 = 100 - p2
+	case !ok2:
+		p2 = 100 - p1
+	}
+	sum := p1 + p2
+	if sum == 0 {
+		return black, 0, p.failf(ErrOutOfRange, offset, s, "percentages add up to zero")
+	}
+	multiplier := 1.0
+	if sum < 100 {
+		multiplier = sum / 100
+	}
+
+	v, alpha, missing := mixColors(space, hue,
+		toMixColor(space, src1, c1, m1),
+		toMixColor(space, src2, c2, m2),
+		p2/sum)
+
+	// Like color(), the result is not gamut mapped. Out of gamut components
+	// are kept, and clamped by RGBA255.
+	c, _ := fromChannels(space, v, alpha*multiplier)
+	return c, missing, nil
+}
@@ -0,0 +1,96 @@
+package csscolorparser
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_ColorMix(t *testing.T) {
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"color-mix(in srgb, red, blue)", "#800080"},
+		{"color-mix(in srgb, red 40%, blue)", "#660099"},
+		{"color-mix(in srgb, 40% red, blue)", "#660099"},
+		{"color-mix(in srgb, red, 60% blue)", "#660099"},
+		{"color-mix(in srgb, red 60%, blue 90%)", "#660099"},
+		{"color-mix(in srgb, red 20%, blue 20%)", "#80008066"},
+		{"color-mix(in srgb, rgb(255 0 0 / 0.5), blue)", "#5500aabf"},
+		{"color-mix(in srgb-linear, red, blue)", "#bc00bc"},
+		{"color-mix(in xyz, white, black)", "#bcbcbc"},
+		{"color-mix(in oklab, red 100%, blue)", "#ff0000"},
+		{"color-mix(in lab, red 0%, blue)", "#0000ff"},
+		{"color-mix(red, red)", "#ff0000"},
+		{"color-mix(in hsl, red, lime)", "#ffff00"},
+		{"color-mix(in hsl shorter hue, red, lime)", "#ffff00"},
+		{"color-mix(in hsl longer hue, red, lime)", "#0000ff"},
+		{"color-mix(in hsl increasing hue, red, lime)", "#ffff00"},
+		{"color-mix(in hsl decreasing hue, red, lime)", "#0000ff"},
+		{"color-mix(in hwb, white, blue)", "#8080ff"},
+		{"color-mix(in hsl, white, blue)", "#9f9fdf"},
+		{"color-mix(in srgb, rgb(none 0 0), rgb(255 0 0))", "#ff0000"},
+		{"color-mix(in srgb, rgb(255 0 0 / none), rgb(255 0 0 / 0.5))", "#ff000080"},
+		{"color-mix(in srgb, color-mix(in srgb, red, blue), blue)", "#4000bf"},
+		{"rgb(from color-mix(in srgb, red, blue) r g b / 0.5)", "#80008080"},
+		{"COLOR-MIX(IN SRGB, RED, BLUE)", "#800080"},
+		{"color-mix(in srgb, red calc(20% * 2), blue)", "#660099"},
+		{"color-mix(in srgb, min(40%, 50%) red, blue)", "#660099"},
+		// Out of the sRGB gamut
+		{"color-mix(in lch, red, white)", "#ff9f80"},
+		{"color-mix(in oklch, red, white)", "#ffa191"},
+		{"color-mix(in oklch, red 99%, blue)", "#ff0011"},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+	}
+
+	c, err := Parse("color-mix(in lch, red, white)")
+	test(t, err, nil)
+	testTrue(t, c.R > 1)
+	test(t, c.RGBString(), "rgb(255,159,128)")
+	name, _ := c.NearestName()
+	test(t, name, "lightsalmon")
+
+	c, missing, err := ParseMissing("color-mix(in oklch, oklch(none 0.1 120), oklch(0.5 0.2 none))")
+	test(t, err, nil)
+	test(t, missing, Missing(0))
+	test(t, c.HexString(), FromOklch(0.5, 0.15, 120*math.Pi/180, 1).HexString())
+
+	_, missing, err = ParseMissing("color-mix(in srgb, rgb(none 0 0), rgb(none 0 0 / none))")
+	test(t, err, nil)
+	test(t, missing, Missing0)
+
+	// Missing lightness is carried into analogous component of another space
+	_, missing, err = ParseMissing("color-mix(in oklch, hsl(0 50% none), oklab(none 0 0))")
+	test(t, err, nil)
+	test(t, missing, Missing0|Missing2)
+
+	invalid := []string{
+		"color-mix()",
+		"color-mix(in srgb, red)",
+		"color-mix(in srgb, red, blue, lime)",
+		"color-mix(in foo, red, blue)",
+		"color-mix(in rgb, red, blue)",
+		"color-mix(srgb, red, blue)",
+		"color-mix(in srgb longer hue, red, blue)",
+		"color-mix(in hsl longest hue, red, blue)",
+		"color-mix(in hsl longer, red, blue)",
+		"color-mix(in srgb, red 0%, blue 0%)",
+		"color-mix(in srgb, red 120%, blue)",
+		"color-mix(in srgb, red -10%, blue)",
+		"color-mix(in srgb, red 60%, blue 140%)",
+		"color-mix(in srgb, red 10% 10%, blue)",
+		"color-mix(in srgb, red blue, blue)",
+		"color-mix(in srgb, red 40, blue)",
+		"color-mix(in srgb, red calc(40), blue)",
+		"color-mix(in srgb, red calc(120%), blue)",
+		"color-mix(in srgb, bloodred, blue)",
+	}
+	for _, s := range invalid {
+		_, err := Parse(s)
+		testTrue(t, err != nil)
+	}
+}
@@ -0,0 +1,253 @@
+package csscolorparser
+
+import "math"
+
+// Predefined color spaces of the CSS color() function.
+// https://www.w3.org/TR/css-color-4/#predefined
+
+type mat3 [3][3]float64
+
+func (m mat3) mul(a, b, c float64) (x, y, z float64) {
+	x = m[0][0]*a + m[0][1]*b + m[0][2]*c
+	y = m[1][0]*a + m[1][1]*b + m[1][2]*c
+	z = m[2][0]*a + m[2][1]*b + m[2][2]*c
+	return
+}
+
+func (m mat3) inverse() mat3 {
+	a, b, c := m[0][0], m[0][1], m[0][2]
+	d, e, f := m[1][0], m[1][1], m[1][2]
+	g, h, i := m[2][0], m[2][1], m[2][2]
+
+	A := e*i - f*h
+	B := f*g - d*i
+	C := d*h - e*g
+	det := a*A + b*B + c*C
+
+	return mat3{
+		{A / det, (c*h - b*i) / det, (b*f - c*e) / det},
+		{B / det, (a*i - c*g) / det, (c*d - a*f) / det},
+		{C / det, (b*g - a*h) / det, (a*e - b*d) / det},
+	}
+}
+
+var (
+	identityMat = mat3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+	linearSrgbToXyz65 = mat3{
+		{506752.0 / 1228815, 87881.0 / 245763, 12673.0 / 70218},
+		{87098.0 / 409605, 175762.0 / 245763, 12673.0 / 175545},
+		{7918.0 / 409605, 87881.0 / 737289, 1001167.0 / 1053270},
+	}
+	xyz65ToLinearSrgb = mat3{
+		{12831.0 / 3959, -329.0 / 214, -1974.0 / 3959},
+		{-851781.0 / 878810, 1648619.0 / 878810, 36519.0 / 878810},
+		{705.0 / 12673, -2585.0 / 12673, 705.0 / 667},
+	}
+	linearP3ToXyz65 = mat3{
+		{608311.0 / 1250200, 189793.0 / 714400, 198249.0 / 1000160},
+		{35783.0 / 156275, 247089.0 / 357200, 198249.0 / 2500400},
+		{0, 32229.0 / 714400, 5220557.0 / 5000800},
+	}
+	linearA98ToXyz65 = mat3{
+		{573536.0 / 994567, 263643.0 / 1420810, 187206.0 / 994567},
+		{591459.0 / 1989134, 6239551.0 / 9945670, 374412.0 / 4972835},
+		{53769.0 / 1989134, 351524.0 / 4972835, 4929758.0 / 4972835},
+	}
+	linearProPhotoToXyz50 = mat3{
+		{0.7977666449006423, 0.13518129740053308, 0.0313477341283922858},
+		{0.2880748288194013, 0.711835234241873, 0.00008993693872564},
+		{0, 0, 0.8251046025104602},
+	}
+	linearRec2020ToXyz65 = mat3{
+		{63426534.0 / 99577255, 20160776.0 / 139408157, 47086771.0 / 278816314},
+		{26158966.0 / 99577255, 472592308.0 / 697040785, 8267143.0 / 139408157},
+		{0, 19567812.0 / 697040785, 295819943.0 / 278816314},
+	}
+	// Bradford chromatic adaptation
+	xyz50ToXyz65 = mat3{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+	xyz65ToXyz50 = xyz50ToXyz65.inverse()
+)
+
+func signPow(x, p float64) float64 {
+	if x < 0 {
+		return -math.Pow(-x, p)
+	}
+	return math.Pow(x, p)
+}
+
+func toLinear(x float64) float64 {
+	if math.Abs(x) <= 0.04045 {
+		return x / 12.92
+	}
+	return sign(x) * math.Pow((math.Abs(x)+0.055)/1.055, 2.4)
+}
+
+// Like fromLinear, but extended to negative values.
+func fromLinearExtended(x float64) float64 {
+	if math.Abs(x) > 0.0031308 {
+		return sign(x) * (1.055*math.Pow(math.Abs(x), 1/2.4) - 0.055)
+	}
+	return 12.92 * x
+}
+
+func a98ToLinear(x float64) float64 {
+	return signPow(x, 563.0/256)
+}
+
+func a98FromLinear(x float64) float64 {
+	return signPow(x, 256.0/563)
+}
+
+func proPhotoToLinear(x float64) float64 {
+	if math.Abs(x) <= 16.0/512 {
+		return x / 16
+	}
+	return signPow(x, 1.8)
+}
+
+func proPhotoFromLinear(x float64) float64 {
+	if math.Abs(x) >= 1.0/512 {
+		return signPow(x, 1/1.8)
+	}
+	return 16 * x
+}
+
+func rec2020ToLinear(x float64) float64 {
+	const (
+		alpha = 1.09929682680944
+		beta  = 0.018053968510807
+	)
+	if math.Abs(x) < beta*4.5 {
+		return x / 4.5
+	}
+	return sign(x) * math.Pow((math.Abs(x)+alpha-1)/alpha, 1/0.45)
+}
+
+func rec2020FromLinear(x float64) float64 {
+	const (
+		alpha = 1.09929682680944
+		beta  = 0.018053968510807
+	)
+	if math.Abs(x) > beta {
+		return sign(x) * (alpha*math.Pow(math.Abs(x), 0.45) - (alpha - 1))
+	}
+	return 4.5 * x
+}
+
+func sign(x float64) float64 {
+	if x < 0 {
+		return -1
+	}
+	return 1
+}
+
+func identity(x float64) float64 {
+	return x
+}
+
+type predefinedSpace struct {
+	toLinear   func(float64) float64
+	fromLinear func(float64) float64
+	toXyz      mat3
+	d50        bool
+}
+
+var predefinedSpaces = map[string]predefinedSpace{
+	"srgb":         {toLinear, fromLinearExtended, linearSrgbToXyz65, false},
+	"srgb-linear":  {identity, identity, linearSrgbToXyz65, false},
+	"display-p3":   {toLinear, fromLinearExtended, linearP3ToXyz65, false},
+	"a98-rgb":      {a98ToLinear, a98FromLinear, linearA98ToXyz65, false},
+	"prophoto-rgb": {proPhotoToLinear, proPhotoFromLinear, linearProPhotoToXyz50, true},
+	"rec2020":      {rec2020ToLinear, rec2020FromLinear, linearRec2020ToXyz65, false},
+	"xyz":          {identity, identity, identityMat, false},
+	"xyz-d65":      {identity, identity, identityMat, false},
+	"xyz-d50":      {identity, identity, identityMat, true},
+}
+
+// fromPredefined converts components in the named predefined color space to a Color.
+func fromPredefined(space string, r, g, b, alpha float64) (Color, bool) {
+	ps, ok := predefinedSpaces[space]
+	if !ok {
+		return black, false
+	}
+	x, y, z := ps.toXyz.mul(ps.toLinear(r), ps.toLinear(g), ps.toLinear(b))
+	if ps.d50 {
+		x, y, z = xyz50ToXyz65.mul(x, y, z)
+	}
+	R, G, B := xyz65ToLinearSrgb.mul(x, y, z)
+	return Color{fromLinearExtended(R), fromLinearExtended(G), fromLinearExtended(B), clamp0_1(alpha)}, true
+}
+
+// toPredefined converts a Color to components in the named predefined color space.
+func toPredefined(space string, c Color) (v [3]float64, ok bool) {
+	ps, ok := predefinedSpaces[space]
+	if !ok {
+		return
+	}
+	x, y, z := linearSrgbToXyz65.mul(toLinear(c.R), toLinear(c.G), toLinear(c.B))
+	if ps.d50 {
+		x, y, z = xyz65ToXyz50.mul(x, y, z)
+	}
+	r, g, b := ps.toXyz.inverse().mul(x, y, z)
+	return [3]float64{ps.fromLinear(r), ps.fromLinear(g), ps.fromLinear(b)}, true
+}
+
+// toChannels converts a Color to the channel values of a color function or
+// predefined color space, in the reference ranges of the relative color syntax.
+// Hues are in degrees.
+func toChannels(space string, c Color) (v [3]float64, ok bool) {
+	switch space {
+	case "rgb", "rgba":
+		return [3]float64{c.R * 255, c.G * 255, c.B * 255}, true
+	case "hsl", "hsla":
+		h, s, l := rgbToHsl(c.R, c.G, c.B)
+		return [3]float64{h, s * 100, l * 100}, true
+	case "hwb", "hwba":
+		h, w, b := rgbToHwb(c.R, c.G, c.B)
+		return [3]float64{h, w * 100, b * 100}, true
+	case "hsv", "hsva":
+		h, s, v := rgbToHsv(c.R, c.G, c.B)
+		return [3]float64{h, s * 100, v * 100}, true
+	case "lab", "lch":
+		l, a, b, _ := c.ToLab()
+		if space == "lch" {
+			return [3]float64{l, math.Hypot(a, b), normalizeAngle(math.Atan2(b, a) * 180 / math.Pi)}, true
+		}
+		return [3]float64{l, a, b}, true
+	case "oklab", "oklch":
+		l, a, b := linearRgbToOklab(toLinear(c.R), toLinear(c.G), toLinear(c.B))
+		if space == "oklch" {
+			return [3]float64{l, math.Hypot(a, b), normalizeAngle(math.Atan2(b, a) * 180 / math.Pi)}, true
+		}
+		return [3]float64{l, a, b}, true
+	}
+	return toPredefined(space, c)
+}
+
+// fromChannels is the inverse of toChannels.
+func fromChannels(space string, v [3]float64, alpha float64) (Color, bool) {
+	switch space {
+	case "rgb", "rgba":
+		return Color{v[0] / 255, v[1] / 255, v[2] / 255, clamp0_1(alpha)}, true
+	case "hsl", "hsla":
+		return FromHsl(v[0], v[1]/100, v[2]/100, alpha), true
+	case "hwb", "hwba":
+		return FromHwb(v[0], v[1]/100, v[2]/100, alpha), true
+	case "hsv", "hsva":
+		return FromHsv(v[0], v[1]/100, v[2]/100, alpha), true
+	case "lab":
+		return FromLab(v[0], v[1], v[2], alpha), true
+	case "lch":
+		return FromLch(v[0], v[1], v[2]*math.Pi/180, alpha), true
+	case "oklab":
+		return FromOklab(v[0], v[1], v[2], alpha), true
+	case "oklch":
+		return FromOklch(v[0], v[1], v[2]*math.Pi/180, alpha), true
+	}
+	return fromPredefined(space, v[0], v[1], v[2], alpha)
+}
@@ -4,7 +4,6 @@ package csscolorparser
 import (
 	"fmt"
 	"math"
-	"strconv"
 	"strings"
 )
 
@@ -15,8 +14,9 @@ type Color struct {
 	R, G, B, A float64
 }
 
-// Implement the Go color.Color interface.
+// Implement the Go color.Color interface. Out of gamut colors are clamped.
 func (c Color) RGBA() (r, g, b, a uint32) {
+	c = c.Clamp()
 	r = uint32(c.R*c.A*65535 + 0.5)
 	g = uint32(c.G*c.A*65535 + 0.5)
 	b = uint32(c.B*c.A*65535 + 0.5)
@@ -24,8 +24,10 @@ func (c Color) RGBA() (r, g, b, a uint32) {
 	return
 }
 
-// RGBA255 returns R, G, B, A values in the range 0..255
+// RGBA255 returns R, G, B, A values in the range 0..255. Out of gamut
+// colors are clamped.
 func (c Color) RGBA255() (r, g, b, a uint8) {
+	c = c.Clamp()
 	r = uint8(c.R*255 + 0.5)
 	g = uint8(c.G*255 + 0.5)
 	b = uint8(c.B*255 + 0.5)
@@ -61,16 +63,23 @@ func (c Color) RGBString() string {
 	return fmt.Sprintf("rgb(%d,%d,%d)", r, g, b)
 }
 
-// Name returns name of this color if its available.
+// Name returns name of this color if its available in DefaultRegistry.
+// If the color has several names, the preferred one is returned, see
+// Registry.NamesOf.
 func (c Color) Name() (string, bool) {
-	r, g, b, _ := c.RGBA255()
-	rgb := [3]uint8{r, g, b}
-	for k, v := range namedColors {
-		if v == rgb {
-			return k, true
-		}
-	}
-	return "", false
+	return DefaultRegistry.Name(c)
+}
+
+// NearestName returns the name of the closest color in DefaultRegistry and
+// its distance, see Registry.Nearest.
+func (c Color) NearestName() (string, float64) {
+	name, d, _ := DefaultRegistry.Nearest(c)
+	return name, d
+}
+
+// Names returns all names of this color in DefaultRegistry, in preferred order.
+func (c Color) Names() []string {
+	return DefaultRegistry.NamesOf(c)
 }
 
 // Implement the Go TextUnmarshaler interface
@@ -130,6 +139,54 @@ func FromHwb(h, w, b, a float64) Color {
 	return Color{r, g, b, clamp0_1(a)}
 }
 
+// ToHsl returns the HSL components of the color, the inverse of FromHsl.
+// The color is clamped to the sRGB gamut first. The hue of grays is
+// powerless and is 0, like in CSS serialization; their saturation is 0.
+//
+// Returns:
+//
+//   - h: Hue angle [0..360)
+//   - s: Saturation [0..1]
+//   - l: Lightness [0..1]
+//   - a: Alpha [0..1]
+func (c Color) ToHsl() (h, s, l, a float64) {
+	c = c.Clamp()
+	h, s, l = rgbToHsl(c.R, c.G, c.B)
+	return h, s, l, c.A
+}
+
+// ToHsv returns the HSV components of the color, the inverse of FromHsv.
+// The color is clamped to the sRGB gamut first. The hue of grays is 0;
+// their saturation is 0.
+//
+// Returns:
+//
+//   - h: Hue angle [0..360)
+//   - s: Saturation [0..1]
+//   - v: Value [0..1]
+//   - a: Alpha [0..1]
+func (c Color) ToHsv() (h, s, v, a float64) {
+	c = c.Clamp()
+	h, s, v = rgbToHsv(c.R, c.G, c.B)
+	return h, s, v, c.A
+}
+
+// ToHwb returns the HWB components of the color, the inverse of FromHwb.
+// The color is clamped to the sRGB gamut first. The hue of grays is 0;
+// their whiteness and blackness add up to 1.
+//
+// Returns:
+//
+//   - h: Hue angle [0..360)
+//   - w: Whiteness [0..1]
+//   - b: Blackness [0..1]
+//   - a: Alpha [0..1]
+func (c Color) ToHwb() (h, w, b, a float64) {
+	c = c.Clamp()
+	h, w, b = rgbToHwb(c.R, c.G, c.B)
+	return h, w, b, c.A
+}
+
 func fromLinear(x float64) float64 {
 	if x >= 0.0031308 {
 		return 1.055*math.Pow(x, 1.0/2.4) - 0.055
@@ -158,15 +215,10 @@ func FromLinearRGB(r, g, b, a float64) Color {
 //   - b: How blue/yellow the color is
 //   - alpha: Alpha [0..1]
 func FromOklab(l, a, b, alpha float64) Color {
-	l_ := math.Pow(l+0.3963377774*a+0.2158037573*b, 3)
-	m_ := math.Pow(l-0.1055613458*a-0.0638541728*b, 3)
-	s_ := math.Pow(l-0.0894841775*a-1.2914855480*b, 3)
-
-	R := 4.0767416621*l_ - 3.3077115913*m_ + 0.2309699292*s_
-	G := -1.2684380046*l_ + 2.6097574011*m_ - 0.3413193965*s_
-	B := -0.0041960863*l_ - 0.7034186147*m_ + 1.7076147010*s_
-
-	return FromLinearRGB(R, G, B, alpha)
+	l_, m_, s_ := oklabToLms.mul(l, a, b)
+	R, G, B := lmsToLinearSrgb.mul(l_*l_*l_, m_*m_*m_, s_*s_*s_)
+	// Out of gamut, keep the sign of negative channels
+	return Color{fromLinearExtended(R), fromLinearExtended(G), fromLinearExtended(B), clamp0_1(alpha)}
 }
 
 // FromOklch creates a Color from OKLCh colors.
@@ -181,102 +233,392 @@ func FromOklch(l, c, h, alpha float64) Color {
 	return FromOklab(l, c*math.Cos(h), c*math.Sin(h), alpha)
 }
 
-func labToXyz(l, a, b float64) (x, y, z float64) {
-	const (
-		e  = 216.0 / 24389.0
-		k  = 24389.0 / 27.0
-		Xn = 0.95047
-		Yn = 1.00000
-		Zn = 1.08883
-	)
-
-	fy := (l + 16.0) / 116.0
-	fx := fy + (a / 500.0)
-	fz := fy - (b / 200.0)
-
-	if math.Pow(fy, 3) <= e {
-		y = (Yn * (fy*7.787 + 16.0/116.0))
-	} else {
-		y = (Yn * math.Pow(fy, 3))
+// Oklab matrices, the forward ones are the exact inverses of the ones
+// used by FromOklab so that conversions round-trip.
+// https://bottosson.github.io/posts/oklab/
+var (
+	oklabToLms = mat3{
+		{1, 0.3963377774, 0.2158037573},
+		{1, -0.1055613458, -0.0638541728},
+		{1, -0.0894841775, -1.2914855480},
+	}
+	lmsToLinearSrgb = mat3{
+		{4.0767416621, -3.3077115913, 0.2309699292},
+		{-1.2684380046, 2.6097574011, -0.3413193965},
+		{-0.0041960863, -0.7034186147, 1.7076147010},
 	}
+	lmsToOklab      = oklabToLms.inverse()
+	linearSrgbToLms = lmsToLinearSrgb.inverse()
+)
 
-	if math.Pow(fx, 3) <= e {
-		x = (Xn * (fx*7.787 + 16.0/116.0))
-	} else {
-		x = (Xn * math.Pow(fx, 3))
+func linearRgbToOklab(r, g, b float64) (L, A, B float64) {
+	l, m, s := linearSrgbToLms.mul(r, g, b)
+	return lmsToOklab.mul(math.Cbrt(l), math.Cbrt(m), math.Cbrt(s))
+}
+
+// ToLinearRGB returns the linear-light RGB components of the color, the
+// inverse of FromLinearRGB. Out of gamut components are not clamped.
+//
+// Returns:
+//
+//   - r: Red value [0..1]
+//   - g: Green value [0..1]
+//   - b: Blue value [0..1]
+//   - a: Alpha value [0..1]
+func (c Color) ToLinearRGB() (r, g, b, a float64) {
+	return toLinear(c.R), toLinear(c.G), toLinear(c.B), c.A
+}
+
+// ToOklab returns the Oklab components of the color, the inverse of FromOklab.
+//
+// Returns:
+//
+//   - l: Perceived lightness
+//   - a: How green/red the color is
+//   - b: How blue/yellow the color is
+//   - alpha: Alpha [0..1]
+func (c Color) ToOklab() (l, a, b, alpha float64) {
+	l, a, b = linearRgbToOklab(toLinear(c.R), toLinear(c.G), toLinear(c.B))
+	return l, a, b, c.A
+}
+
+// ToOklch returns the OKLCh components of the color, the inverse of
+// FromOklch. The hue of grays, whose chroma is about 0, is meaningless.
+//
+// Returns:
+//
+//   - l: Perceived lightness
+//   - ch: Chroma
+//   - h: Hue angle in radians [0..2π)
+//   - alpha: Alpha [0..1]
+func (c Color) ToOklch() (l, ch, h, alpha float64) {
+	l, a, b, alpha := c.ToOklab()
+	h = math.Atan2(b, a)
+	if h < 0 {
+		h += 2 * math.Pi
 	}
+	return l, math.Hypot(a, b), h, alpha
+}
 
-	if math.Pow(fz, 3) <= e {
-		z = (Zn * (fz*7.787 + 16.0/116.0))
-	} else {
-		z = (Zn * math.Pow(fz, 3))
+// CIE Lab, relative to the D50 white point of CSS Color 4
+// https://www.w3.org/TR/css-color-4/#color-conversion-code
+const (
+	labEpsilon = 216.0 / 24389.0
+	labKappa   = 24389.0 / 27.0
+)
+
+var d50White = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+
+// labToXyz converts Lab to XYZ D50.
+func labToXyz(l, a, b float64) (x, y, z float64) {
+	fy := (l + 16) / 116
+	fx := fy + a/500
+	fz := fy - b/200
+
+	f := func(t float64) float64 {
+		if t3 := t * t * t; t3 > labEpsilon {
+			return t3
+		}
+		return (116*t - 16) / labKappa
 	}
 
-	return
+	y = l / labKappa
+	if l > labKappa*labEpsilon {
+		y = fy * fy * fy
+	}
+	return f(fx) * d50White[0], y * d50White[1], f(fz) * d50White[2]
 }
 
-func xyzToLinearRgb(x, y, z float64) (r, g, b float64) {
-	r = 3.2404537*x - 1.537177*y - 0.498531*z
-	g = -0.969266*x + 1.876010*y + 0.041556*z
-	b = 0.055643*x - 0.204025*y + 1.057225*z
+// xyzToLab converts XYZ D50 to Lab.
+func xyzToLab(x, y, z float64) (l, a, b float64) {
+	f := func(t float64) float64 {
+		if t > labEpsilon {
+			return math.Cbrt(t)
+		}
+		return (labKappa*t + 16) / 116
+	}
+
+	fx := f(x / d50White[0])
+	fy := f(y / d50White[1])
+	fz := f(z / d50White[2])
+
+	l = 116*fy - 16
+	a = 500 * (fx - fy)
+	b = 200 * (fy - fz)
 	return
 }
 
+// FromLab creates a Color from CIE Lab colors, relative to the D50 white
+// point like the CSS lab() function.
+//
+// Arguments:
+//
+//   - l: Lightness [0..100]
+//   - a: How green/red the color is, about [-125..125]
+//   - b: How blue/yellow the color is, about [-125..125]
+//   - alpha: Alpha [0..1]
 func FromLab(l, a, b, alpha float64) Color {
-	x, y, z := labToXyz(l, a, b)
-	R, G, B := xyzToLinearRgb(x, y, z)
-	return FromLinearRGB(R, G, B, alpha)
+	x, y, z := xyz50ToXyz65.mul(labToXyz(l, a, b))
+	R, G, B := xyz65ToLinearSrgb.mul(x, y, z)
+	// Out of gamut, keep the sign of negative channels
+	return Color{fromLinearExtended(R), fromLinearExtended(G), fromLinearExtended(B), clamp0_1(alpha)}
 }
 
+// FromLch creates a Color from CIE LCH colors, relative to the D50 white
+// point like the CSS lch() function.
+//
+// Arguments:
+//
+//   - l: Lightness [0..100]
+//   - c: Chroma, about [0..150]
+//   - h: Hue angle in radians
+//   - alpha: Alpha [0..1]
 func FromLch(l, c, h, alpha float64) Color {
 	return FromLab(l, c*math.Cos(h), c*math.Sin(h), alpha)
 }
 
+// ToLab returns the CIE Lab components of the color, relative to the D50
+// white point, the inverse of FromLab.
+//
+// Returns:
+//
+//   - l: Lightness [0..100]
+//   - a: How green/red the color is
+//   - b: How blue/yellow the color is
+//   - alpha: Alpha [0..1]
+func (c Color) ToLab() (l, a, b, alpha float64) {
+	x, y, z := linearSrgbToXyz65.mul(toLinear(c.R), toLinear(c.G), toLinear(c.B))
+	l, a, b = xyzToLab(xyz65ToXyz50.mul(x, y, z))
+	return l, a, b, c.A
+}
+
+// ToLch returns the CIE LCH components of the color, relative to the D50
+// white point, the inverse of FromLch. The hue of grays, whose chroma is
+// about 0, is meaningless.
+//
+// Returns:
+//
+//   - l: Lightness [0..100]
+//   - ch: Chroma
+//   - h: Hue angle in radians [0..2π)
+//   - alpha: Alpha [0..1]
+func (c Color) ToLch() (l, ch, h, alpha float64) {
+	l, a, b, alpha := c.ToLab()
+	h = math.Atan2(b, a)
+	if h < 0 {
+		h += 2 * math.Pi
+	}
+	return l, math.Hypot(a, b), h, alpha
+}
+
 var black = Color{0, 0, 0, 1}
 
 // Parse parses CSS color string and returns, if successful, a Color.
 func Parse(s string) (Color, error) {
-	input := s
-	s = strings.TrimSpace(strings.ToLower(s))
+	c, _, err := ParseMissing(s)
+	return c, err
+}
+
+// Missing is a set of color components specified with the `none` keyword.
+// The first three bits are the channels of the color function in the order
+// they are written, e.g. l, c, h for oklch().
+type Missing uint8
+
+const (
+	Missing0 Missing = 1 << iota
+	Missing1
+	Missing2
+	MissingAlpha
+)
+
+// ParseMissing is like Parse, but also reports which components were
+// specified as `none`. Missing components are treated as zero.
+func ParseMissing(s string) (Color, Missing, error) {
+	return newParser(nil).parse(s)
+}
+
+func (p *parser) parse(s string) (Color, Missing, error) {
+	p.input = s
+	s, err := p.substituteVars(s)
+	if err != nil {
+		return black, 0, err
+	}
+	p.input = s
+	c, missing, err := p.parseColor(s, 0)
+	if err == nil && p.ctx.Profile != nil && p.ctx.Profile.Quantize {
+		c = quantize(c)
+	}
+	return c, missing, err
+}
+
+// parseColor parses s, which starts at offset in p.input.
+func (p *parser) parseColor(s string, offset int) (Color, Missing, error) {
+	var missing Missing
+	p.depth++
+	defer func() { p.depth-- }()
+
+	offset += len(s) - len(strings.TrimLeft(s, " \t\n\r\f"))
+	s = asciiLower(strings.TrimSpace(s))
+
+	if s == "" {
+		return black, 0, p.failf(ErrInvalidFormat, offset, s, "empty string")
+	}
 
 	if s == "transparent" {
-		return Color{0, 0, 0, 0}, nil
+		return Color{0, 0, 0, 0}, 0, nil
+	}
+
+	if s == "currentcolor" {
+		c, ok := p.currentColor()
+		if !ok {
+			return black, 0, p.fail(ErrNoCurrentColor, offset, s)
+		}
+		return c, 0, nil
 	}
 
 	// Predefined name / keyword
-	c, ok := namedColors[s]
-	if ok {
-		return Color{float64(c[0]) / 255, float64(c[1]) / 255, float64(c[2]) / 255, 1}, nil
+	if c, ok := p.registry().Lookup(s); ok {
+		return c, 0, nil
+	}
+
+	if c, ok := p.systemColor(s); ok {
+		if p.ctx.SystemColorUsed != nil {
+			p.ctx.SystemColorUsed(s)
+		}
+		return c, 0, nil
 	}
 
 	// Hexadecimal
 	if strings.HasPrefix(s, "#") {
 		c, ok := parseHex(s[1:])
+		if !ok && !p.strict() {
+			c, ok = parseLongHex(s[1:])
+		}
 		if ok {
-			return c, nil
+			return c, 0, nil
 		}
-		return black, fmt.Errorf("Invalid hex color, %s", input)
+		return black, 0, p.hexError(s[1:], offset+1)
+	}
+
+	if c, ok, err := p.x11Color(s, offset); ok {
+		return c, 0, err
 	}
 
 	op := strings.Index(s, "(")
 
 	if (op != -1) && strings.HasSuffix(s, ")") {
 		fname := strings.TrimSpace(s[:op])
-		s = s[op+1 : len(s)-1]
-		f := func(c rune) bool {
-			return c == ',' || c == '/' || c == ' '
+		if !colorFunctions[fname] || (p.strict() && nonStandardFunctions[fname]) {
+			return black, 0, p.fail(ErrUnknownFunction, offset, fname)
+		}
+		if !p.supports(fname) {
+			return black, 0, p.failf(ErrUnknownFunction, offset, fname, "not supported by %s", p.ctx.Profile.Name)
+		}
+		argsOffset := offset + op + 1
+		args := s[op+1 : len(s)-1]
+
+		if fname == "light-dark" {
+			return p.lightDark(args, argsOffset)
+		}
+
+		if fname == "color-mix" {
+			return p.parseColorMix(args, argsOffset)
 		}
-		params := strings.FieldsFunc(s, f)
 
-		if len(params) != 3 && len(params) != 4 {
-			return black, fmt.Errorf("Invalid format")
+		if fname == "contrast-color" {
+			return p.contrastColor(args, argsOffset)
+		}
+
+		if fname == "color-contrast" {
+			return p.colorContrast(args, argsOffset)
+		}
+
+		if fname == "device-cmyk" {
+			return p.deviceCMYK(args, argsOffset)
+		}
+
+		legacy := false
+		if p.strict() {
+			var err error
+			if legacy, err = p.checkSeparators(fname, args, argsOffset); err != nil {
+				return black, 0, err
+			}
+		}
+
+		params, offsets := splitParams(args)
+		for i := range offsets {
+			offsets[i] += argsOffset
+		}
+
+		if len(params) > 0 && params[0] == "from" {
+			if p.ctx.Profile != nil && !p.ctx.Profile.Relative {
+				return black, 0, p.failf(ErrInvalidFormat, offsets[0], params[0], "relative colors are not supported by %s", p.ctx.Profile.Name)
+			}
+			var err error
+			params, offsets, err = p.resolveRelative(fname, params, offsets)
+			if err != nil {
+				return black, 0, err
+			}
+		}
+
+		first := 0
+		if fname == "color" {
+			first = 1
+			if len(params) == 0 {
+				return black, 0, p.fail(ErrWrongArity, offset, s)
+			}
+			if _, ok := predefinedSpaces[params[0]]; !ok {
+				return black, 0, p.fail(ErrUnknownColorSpace, offsets[0], params[0])
+			}
+		}
+
+		if n := len(params) - first; n != 3 && n != 4 {
+			return black, 0, p.failf(ErrWrongArity, offset, s, "expected 3 or 4 components, got %d", n)
+		}
+
+		for i := first; i < len(params); i++ {
+			if params[i] == "none" {
+				if legacy {
+					return black, 0, p.failf(ErrInvalidFormat, offsets[i], params[i], "none is not allowed in the legacy syntax")
+				}
+				if p.ctx.Profile != nil && !p.ctx.Profile.None {
+					return black, 0, p.failf(ErrInvalidFormat, offsets[i], params[i], "none is not supported by %s", p.ctx.Profile.Name)
+				}
+				if i-first < 3 {
+					missing |= Missing0 << uint(i-first)
+				} else {
+					missing |= MissingAlpha
+				}
+				params[i] = "0"
+				continue
+			}
+			angle := i-first == hueChannels[fname]
+			if err := p.checkComponent(params[i], offsets[i], angle); err != nil {
+				return black, 0, err
+			}
+		}
+
+		if legacy || (p.ctx.Profile != nil && p.ctx.Profile.LegacyUnits) {
+			if err := p.checkLegacyUnits(fname, params, offsets); err != nil {
+				return black, 0, err
+			}
+		}
+
+		if fname == "color" {
+			v, alpha, ok := parseColorFunction(params)
+			if ok {
+				p.setValue(params[0], v, alpha)
+				c, _ := fromPredefined(params[0], v[0], v[1], v[2], alpha)
+				return c, missing, nil
+			}
+			return black, 0, p.fail(ErrInvalidFormat, offset, s)
 		}
 
 		alpha := 1.0
 		if len(params) == 4 {
 			v, ok, _ := parsePercentOrFloat(params[3])
 			if !ok {
-				return black, fmt.Errorf("Invalid format")
+				return black, 0, p.fail(ErrBadNumber, offsets[3], params[3])
 			}
 			alpha = clamp0_1(v)
 		}
@@ -287,34 +629,32 @@ func Parse(s string) (Color, error) {
 			b, okB, _ := parsePercentOr255(params[2])
 
 			if okR && okG && okB {
-				return Color{
-					clamp0_1(r),
-					clamp0_1(g),
-					clamp0_1(b),
-					alpha,
-				}, nil
+				r, g, b = clamp0_1(r), clamp0_1(g), clamp0_1(b)
+				p.setValue("rgb", [3]float64{r * 255, g * 255, b * 255}, alpha)
+				return Color{r, g, b, alpha}, missing, nil
 			}
-			return black, fmt.Errorf("Wrong %s() components, %s", fname, input)
 
 		} else if fname == "hsl" || fname == "hsla" {
 			h, okH := parseAngle(params[0])
-			s, okS, _ := parsePercentOrFloat(params[1])
-			l, okL, _ := parsePercentOrFloat(params[2])
+			s, okS, pctS := parsePercentOrFloat(params[1])
+			l, okL, pctL := parsePercentOrFloat(params[2])
 
 			if okH && okS && okL {
-				return FromHsl(h, s, l, alpha), nil
+				s, l = p.hundreds(s, pctS), p.hundreds(l, pctL)
+				p.setValue("hsl", [3]float64{h, s * 100, l * 100}, alpha)
+				return FromHsl(h, s, l, alpha), missing, nil
 			}
-			return black, fmt.Errorf("Wrong %s() components, %s", fname, input)
 
 		} else if fname == "hwb" || fname == "hwba" {
 			H, okH := parseAngle(params[0])
-			W, okW, _ := parsePercentOrFloat(params[1])
-			B, okB, _ := parsePercentOrFloat(params[2])
+			W, okW, pctW := parsePercentOrFloat(params[1])
+			B, okB, pctB := parsePercentOrFloat(params[2])
 
 			if okH && okW && okB {
-				return FromHwb(H, W, B, alpha), nil
+				W, B = p.hundreds(W, pctW), p.hundreds(B, pctB)
+				p.setValue("hwb", [3]float64{H, W * 100, B * 100}, alpha)
+				return FromHwb(H, W, B, alpha), missing, nil
 			}
-			return black, fmt.Errorf("Wrong hwb() components, %s", input)
 
 		} else if fname == "hsv" || fname == "hsva" {
 			h, okH := parseAngle(params[0])
@@ -322,9 +662,9 @@ func Parse(s string) (Color, error) {
 			v, okV, _ := parsePercentOrFloat(params[2])
 
 			if okH && okS && okV {
-				return FromHsv(h, s, v, alpha), nil
+				p.setValue("hsv", [3]float64{h, s * 100, v * 100}, alpha)
+				return FromHsv(h, s, v, alpha), missing, nil
 			}
-			return black, fmt.Errorf("Wrong hsv() components, %s", input)
 
 		} else if fname == "oklab" {
 			l, okL, _ := parsePercentOrFloat(params[0])
@@ -338,9 +678,10 @@ func Parse(s string) (Color, error) {
 				if fmtB {
 					b = remap(b, -1.0, 1.0, -0.4, 0.4)
 				}
-				return FromOklab(math.Max(l, 0), a, b, alpha), nil
+				l = math.Max(l, 0)
+				p.setValue("oklab", [3]float64{l, a, b}, alpha)
+				return FromOklab(l, a, b, alpha), missing, nil
 			}
-			return black, fmt.Errorf("Wrong oklab() components, %s", input)
 
 		} else if fname == "oklch" {
 			l, okL, _ := parsePercentOrFloat(params[0])
@@ -351,9 +692,10 @@ func Parse(s string) (Color, error) {
 				if fmtC {
 					c = c * 0.4
 				}
-				return FromOklch(math.Max(l, 0), math.Max(c, 0), h*math.Pi/180, alpha), nil
+				l, c = math.Max(l, 0), math.Max(c, 0)
+				p.setValue("oklch", [3]float64{l, c, h}, alpha)
+				return FromOklch(l, c, h*math.Pi/180, alpha), missing, nil
 			}
-			return black, fmt.Errorf("Wrong oklch() components, %s", input)
 		} else if fname == "lab" {
 			l, okL, fmtL := parsePercentOrFloat(params[0])
 			a, okA, fmtA := parsePercentOrFloat(params[1])
@@ -369,9 +711,10 @@ func Parse(s string) (Color, error) {
 				if fmtB {
 					b = remap(b, -1, 1, -125, 125)
 				}
-				return FromLab(math.Max(l, 0), a, b, alpha), nil
+				l = math.Max(l, 0)
+				p.setValue("lab", [3]float64{l, a, b}, alpha)
+				return FromLab(l, a, b, alpha), missing, nil
 			}
-			return black, fmt.Errorf("Invalid lab()")
 		} else if fname == "lch" {
 			l, okL, fmtL := parsePercentOrFloat(params[0])
 			c, okC, fmtC := parsePercentOrFloat(params[1])
@@ -384,19 +727,154 @@ func Parse(s string) (Color, error) {
 				if fmtC {
 					c = c * 150
 				}
-				return FromLch(math.Max(l, 0), math.Max(c, 0), h*math.Pi/180, alpha), nil
+				l, c = math.Max(l, 0), math.Max(c, 0)
+				p.setValue("lch", [3]float64{l, c, h}, alpha)
+				return FromLch(l, c, h*math.Pi/180, alpha), missing, nil
 			}
-			return black, fmt.Errorf("Invalid lch()")
 		}
+		return black, 0, p.fail(ErrInvalidFormat, offset, s)
 	}
 
 	// RGB hexadecimal format without '#' prefix
-	c2, ok2 := parseHex(s)
-	if ok2 {
-		return c2, nil
+	if !p.strict() {
+		if c, ok := parseHex(s); ok {
+			return c, 0, nil
+		}
+	}
+
+	if isIdent(s) {
+		e := p.fail(ErrUnknownName, offset, s)
+		e.Suggestions = p.registry().Suggest(s)
+		return black, 0, e
+	}
+	return black, 0, p.fail(ErrInvalidFormat, offset, s)
+}
+
+// hundreds converts a plain number on the 0..100 scale used by CSS for
+// hsl() and hwb() components. In lenient mode they are on the 0..1 scale.
+func (p *parser) hundreds(v float64, percent bool) float64 {
+	if p.strict() && !percent {
+		return v / 100
+	}
+	return v
+}
+
+// Functions accepted by the parser
+var colorFunctions = map[string]bool{
+	"rgb":            true,
+	"rgba":           true,
+	"hsl":            true,
+	"hsla":           true,
+	"hwb":            true,
+	"hwba":           true,
+	"hsv":            true,
+	"hsva":           true,
+	"lab":            true,
+	"lch":            true,
+	"oklab":          true,
+	"oklch":          true,
+	"color":          true,
+	"color-mix":      true,
+	"light-dark":     true,
+	"contrast-color": true,
+	"color-contrast": true,
+	"device-cmyk":    true,
+}
+
+// Index of the hue channel of each color function
+var hueChannels = map[string]int{
+	"rgb":   -1,
+	"rgba":  -1,
+	"hsl":   0,
+	"hsla":  0,
+	"hwb":   0,
+	"hwba":  0,
+	"hsv":   0,
+	"hsva":  0,
+	"lab":   -1,
+	"lch":   2,
+	"oklab": -1,
+	"oklch": 2,
+	"color": -1,
+}
+
+func isIdent(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isIdentChar(s[i]) {
+			return false
+		}
+	}
+	return s != "" && !isDigit(s[0])
+}
+
+// checkComponent reports whether a component token, starting at offset,
+// is a valid number, percentage or, for hue channels, angle.
+func (p *parser) checkComponent(s string, offset int, hue bool) error {
+	if isMathFunction(s) {
+		v, err := evalMath(s, nil)
+		if err != nil {
+			return p.at(err, offset)
+		}
+		if (hue && v.unit == unitPercent) || (!hue && v.unit == unitAngle) {
+			return p.failf(ErrBadUnit, offset, s, "unexpected %s", v.unit)
+		}
+		return nil
+	}
+	i := scanNumber(s, true)
+	if i == 0 {
+		return p.fail(ErrBadNumber, offset, s)
 	}
+	switch unit := s[i:]; unit {
+	case "":
+		return nil
+	case "%":
+		if !hue {
+			return nil
+		}
+	case "deg", "grad", "rad", "turn":
+		if hue {
+			return nil
+		}
+	}
+	return p.fail(ErrBadUnit, offset+i, s[i:])
+}
 
-	return black, fmt.Errorf("Invalid color format, %s", input)
+// hexError describes why s, starting at offset, is not a valid hex color.
+func (p *parser) hexError(s string, offset int) error {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isDigit(c) && (c < 'a' || c > 'f') {
+			return p.fail(ErrBadHexDigit, offset+i, s[i:i+1])
+		}
+	}
+	if p.strict() {
+		return p.failf(ErrBadHexLength, offset-1, "#"+s, "expected 3, 4, 6 or 8 digits, got %d", len(s))
+	}
+	return p.failf(ErrBadHexLength, offset-1, "#"+s, "expected 3, 4, 6, 8, 9 or 12 digits, got %d", len(s))
+}
+
+// color(<colorspace> c1 c2 c3 [/ alpha])
+func parseColorFunction(params []string) (v [3]float64, alpha float64, ok bool) {
+	if len(params) != 4 && len(params) != 5 {
+		return v, 0, false
+	}
+	alpha = 1
+	if len(params) == 5 {
+		a, ok, _ := parsePercentOrFloat(params[4])
+		if !ok {
+			return v, 0, false
+		}
+		alpha = clamp0_1(a)
+	}
+	for i := range v {
+		f, ok, _ := parsePercentOrFloat(params[i+1])
+		if !ok {
+			return v, 0, false
+		}
+		v[i] = f
+	}
+	_, ok = predefinedSpaces[params[0]]
+	return v, alpha, ok
 }
 
 // https://stackoverflow.com/questions/54197913/parse-hex-string-to-image-color
@@ -437,6 +915,39 @@ func parseHex(s string) (c Color, ok bool) {
 	return
 }
 
+// splitParams splits function arguments on commas, slashes and whitespace,
+// except inside nested parentheses. It also returns the offset of each
+// argument in s.
+func splitParams(s string) ([]string, []int) {
+	var params []string
+	var offsets []int
+	depth := 0
+	start := -1
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && (c == ',' || c == '/' || isSpace(c)):
+			if start != -1 {
+				params = append(params, s[start:i])
+				offsets = append(offsets, start)
+				start = -1
+			}
+			continue
+		}
+		if start == -1 {
+			start = i
+		}
+	}
+	if start != -1 {
+		params = append(params, s[start:])
+		offsets = append(offsets, start)
+	}
+	return params, offsets
+}
+
 func modulo(x, y float64) float64 {
 	return math.Mod(math.Mod(x, y)+y, y)
 }
@@ -509,6 +1020,53 @@ func hsvToRgb(H, S, V float64) (r, g, b float64) {
 	return hslToRgb(h, s, l)
 }
 
+// Hue in degrees [0..360), 0 for achromatic colors
+func rgbToHue(r, g, b float64) float64 {
+	max := math.Max(r, math.Max(g, b))
+	min := math.Min(r, math.Min(g, b))
+	d := max - min
+	if d == 0 {
+		return 0
+	}
+	var h float64
+	switch max {
+	case r:
+		h = (g - b) / d
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return normalizeAngle(h * 60)
+}
+
+func rgbToHsl(r, g, b float64) (h, s, l float64) {
+	max := math.Max(r, math.Max(g, b))
+	min := math.Min(r, math.Min(g, b))
+	l = (max + min) / 2
+	if max != min && l > 0 && l < 1 {
+		s = (max - l) / math.Min(l, 1-l)
+	}
+	h = rgbToHue(r, g, b)
+	return
+}
+
+func rgbToHsv(r, g, b float64) (h, s, v float64) {
+	v = math.Max(r, math.Max(g, b))
+	if v != 0 {
+		s = (v - math.Min(r, math.Min(g, b))) / v
+	}
+	h = rgbToHue(r, g, b)
+	return
+}
+
+func rgbToHwb(r, g, b float64) (h, w, bl float64) {
+	w = math.Min(r, math.Min(g, b))
+	bl = 1 - math.Max(r, math.Max(g, b))
+	h = rgbToHue(r, g, b)
+	return
+}
+
 func clamp0_1(t float64) float64 {
 	if t < 0 {
 		return 0
@@ -519,35 +1077,49 @@ func clamp0_1(t float64) float64 {
 	return t
 }
 
-func parseFloat(s string) (float64, bool) {
-	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
-	return f, err == nil
-}
-
 // Returns (result, ok?, percentage?)
 func parsePercentOrFloat(s string) (float64, bool, bool) {
-	if strings.HasSuffix(s, "%") {
-		f, ok := parseFloat(s[:len(s)-1])
-		if ok {
-			return f / 100, true, true
+	if isMathFunction(s) {
+		v, err := evalMath(s, nil)
+		if err != nil || v.unit == unitAngle {
+			return 0, false, false
+		}
+		if v.unit == unitPercent {
+			return v.v / 100, true, true
 		}
-		return 0, false, true
+		return v.v, true, false
 	}
-	f, ok := parseFloat(s)
-	return f, ok, false
+	f, unit, ok := parseDimension(s)
+	switch {
+	case !ok:
+		return 0, false, false
+	case unit == "%":
+		return f / 100, true, true
+	case unit == "":
+		return f, true, false
+	}
+	return 0, false, false
 }
 
 // Returns (result, ok?, percentage?)
 func parsePercentOr255(s string) (float64, bool, bool) {
-	if strings.HasSuffix(s, "%") {
-		f, ok := parseFloat(s[:len(s)-1])
-		if ok {
-			return f / 100, true, true
+	if isMathFunction(s) {
+		v, err := evalMath(s, nil)
+		if err != nil || v.unit == unitAngle {
+			return 0, false, false
+		}
+		if v.unit == unitPercent {
+			return v.v / 100, true, true
 		}
-		return 0, false, true
+		return v.v / 255, true, false
 	}
-	f, ok := parseFloat(s)
-	if ok {
+	f, unit, ok := parseDimension(s)
+	switch {
+	case !ok:
+		return 0, false, false
+	case unit == "%":
+		return f / 100, true, true
+	case unit == "":
 		return f / 255, true, false
 	}
 	return 0, false, false
@@ -555,31 +1127,28 @@ func parsePercentOr255(s string) (float64, bool, bool) {
 
 // Result angle in degrees (not normalized)
 func parseAngle(s string) (float64, bool) {
-	if strings.HasSuffix(s, "deg") {
-		return parseFloat(s[:len(s)-3])
-	}
-	if strings.HasSuffix(s, "grad") {
-		f, ok := parseFloat(s[:len(s)-4])
-		if ok {
-			return f / 400 * 360, true
+	if isMathFunction(s) {
+		v, err := evalMath(s, nil)
+		if err != nil || v.unit == unitPercent {
+			return 0, false
 		}
-		return 0, false
+		return v.v, true
 	}
-	if strings.HasSuffix(s, "rad") {
-		f, ok := parseFloat(s[:len(s)-3])
-		if ok {
-			return f / math.Pi * 180, true
-		}
+	f, unit, ok := parseDimension(s)
+	if !ok {
 		return 0, false
 	}
-	if strings.HasSuffix(s, "turn") {
-		f, ok := parseFloat(s[:len(s)-4])
-		if ok {
-			return f * 360, true
-		}
-		return 0, false
+	switch unit {
+	case "", "deg":
+		return f, true
+	case "grad":
+		return f / 400 * 360, true
+	case "rad":
+		return f / math.Pi * 180, true
+	case "turn":
+		return f * 360, true
 	}
-	return parseFloat(s)
+	return 0, false
 }
 
 func normalizeAngle(t float64) float64 {
@@ -2,6 +2,7 @@ package csscolorparser
 
 import (
 	"image/color"
+	"math"
 	"testing"
 )
 
@@ -103,8 +104,9 @@ func Test_ParseColor(t *testing.T) {
 		{"rgb(247,179,99)", [4]uint8{247, 179, 99, 255}},
 		{"rgb(50% 50% 50%)", [4]uint8{128, 128, 128, 255}},
 		{"rgb(247,179,99,0.37)", [4]uint8{247, 179, 99, 94}},
-		{"oklab(64.3% 52.6% 40% 2.5%)", [4]uint8{6, 26, 133, 6}},
-		{"oklch(0.46212, 80.9%, 29.23388, 17.33713)", [4]uint8{214, 206, 150, 255}},
+		// Out of gamut, clamped
+		{"oklab(64.3% 52.6% 40% 2.5%)", [4]uint8{255, 26, 0, 6}},
+		{"oklch(0.46212, 80.9%, 29.23388, 17.33713)", [4]uint8{214, 0, 0, 255}},
 		{"hsl(270 0% 50%)", [4]uint8{128, 128, 128, 255}},
 		{"hwb(0 50% 50%)", [4]uint8{128, 128, 128, 255}},
 		{"hsv(0 0% 50%)", [4]uint8{128, 128, 128, 255}},
@@ -149,13 +151,11 @@ func Test_ParseColor(t *testing.T) {
 		test(t, c2.HexString(), s)
 	}
 
-	/*
-		a, err := Parse("#7654CD")
-		test(t, err, nil)
-		b, err := Parse("lab(44.36% 36.05 -58.99)")
-		test(t, err, nil)
-		testColor(t, a, b)
-	*/
+	a, err := Parse("#7654CD")
+	test(t, err, nil)
+	b, err := Parse("lab(44.36% 36.05 -58.99)")
+	test(t, err, nil)
+	testColor(t, a, b)
 }
 
 func Test_MarshalUnmarshal(t *testing.T) {
@@ -345,3 +345,289 @@ func Test_Utils(t *testing.T) {
 		test(t, normalizeAngle(d[0]), d[1])
 	}
 }
+
+func Test_ToHslHsvHwb(t *testing.T) {
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) < 1e-9
+	}
+	data := []struct {
+		s             string
+		hsl, hsv, hwb [4]float64
+	}{
+		{"red", [4]float64{0, 1, 0.5, 1}, [4]float64{0, 1, 1, 1}, [4]float64{0, 0, 0, 1}},
+		{"#0000ff80", [4]float64{240, 1, 0.5, 128.0 / 255}, [4]float64{240, 1, 1, 128.0 / 255}, [4]float64{240, 0, 0, 128.0 / 255}},
+		{"#80ff00", [4]float64{89.88235294117646, 1, 0.5, 1}, [4]float64{89.88235294117646, 1, 1, 1}, [4]float64{89.88235294117646, 0, 0, 1}},
+		{"hsl(330 60% 25%)", [4]float64{330, 0.6, 0.25, 1}, [4]float64{330, 0.75, 0.4, 1}, [4]float64{330, 0.1, 0.6, 1}},
+		{"gray", [4]float64{0, 0, 128.0 / 255, 1}, [4]float64{0, 0, 128.0 / 255, 1}, [4]float64{0, 128.0 / 255, 127.0 / 255, 1}},
+		{"white", [4]float64{0, 0, 1, 1}, [4]float64{0, 0, 1, 1}, [4]float64{0, 1, 0, 1}},
+		{"black", [4]float64{0, 0, 0, 1}, [4]float64{0, 0, 0, 1}, [4]float64{0, 0, 1, 1}},
+		{"color(srgb 1.2 -0.1 0)", [4]float64{0, 1, 0.5, 1}, [4]float64{0, 1, 1, 1}, [4]float64{0, 0, 0, 1}},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		for _, x := range []struct {
+			got, want [4]float64
+			from      func(a, b, c, d float64) Color
+		}{
+			{arr4(c.ToHsl()), d.hsl, FromHsl},
+			{arr4(c.ToHsv()), d.hsv, FromHsv},
+			{arr4(c.ToHwb()), d.hwb, FromHwb},
+		} {
+			for i := range x.got {
+				if !near(x.got[i], x.want[i]) {
+					t.Errorf("%s: got %v, want %v", d.s, x.got, x.want)
+					break
+				}
+			}
+			testColor(t, x.from(x.got[0], x.got[1], x.got[2], x.got[3]), c.Clamp())
+		}
+	}
+
+	// Round trip
+	for _, hex := range []string{"#123456", "#fedcba", "#00ff7f", "#7f7f80", "#ff00ff"} {
+		c, _ := Parse(hex)
+		test(t, FromHsl(c.ToHsl()).HexString(), hex)
+		test(t, FromHsv(c.ToHsv()).HexString(), hex)
+		test(t, FromHwb(c.ToHwb()).HexString(), hex)
+	}
+}
+
+func Test_ToOklab(t *testing.T) {
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) < 1e-9
+	}
+
+	// https://bottosson.github.io/posts/oklab/#table-of-example-xyz-and-oklab-pairs
+	l, a, b, alpha := Color{1, 1, 1, 0.5}.ToOklab()
+	testTrue(t, math.Abs(l-1) < 1e-6 && math.Abs(a) < 1e-6 && math.Abs(b) < 1e-6)
+	test(t, alpha, 0.5)
+	l, a, b, _ = Color{1, 0, 0, 1}.ToOklab()
+	testTrue(t, math.Abs(l-0.627955) < 1e-6 && math.Abs(a-0.224863) < 1e-6 && math.Abs(b-0.125846) < 1e-6)
+
+	l, ch, h, _ := Color{0, 0, 1, 1}.ToOklch()
+	testTrue(t, math.Abs(l-0.452014) < 1e-6 && math.Abs(ch-0.313214) < 1e-6)
+	testTrue(t, math.Abs(h*180/math.Pi-264.052) < 1e-3)
+
+	r, g, bl, _ := Color{1, 0.5, 0.02, 1}.ToLinearRGB()
+	testTrue(t, near(r, 1) && near(g, 0.21404114048223255) && near(bl, 0.02/12.92))
+
+	// Round trip
+	for _, c := range []Color{
+		{0.2, 0.4, 0.6, 1},
+		{1, 0.5, 0, 0.25},
+		{0.9, 0.9, 0.9, 1},
+		{0.01, 0, 0.02, 1},
+		{1.1, 0.05, 0.5, 1},
+	} {
+		for _, x := range []Color{
+			FromOklab(c.ToOklab()),
+			FromOklch(c.ToOklch()),
+			FromLinearRGB(c.ToLinearRGB()),
+		} {
+			if !near(x.R, c.R) || !near(x.G, c.G) || !near(x.B, c.B) || x.A != c.A {
+				t.Errorf("%v: round trip gives %v", c, x)
+			}
+		}
+	}
+
+	// Out of gamut, negative channels keep their sign
+	for _, c := range []Color{{-0.2, 0.5, 0.3, 1}, {1.2, -0.5, 0, 1}} {
+		for _, x := range []Color{FromOklab(c.ToOklab()), FromOklch(c.ToOklch())} {
+			if !near(x.R, c.R) || !near(x.G, c.G) || !near(x.B, c.B) {
+				t.Errorf("%v: round trip gives %v", c, x)
+			}
+		}
+	}
+}
+
+func Test_Lab(t *testing.T) {
+	// https://www.w3.org/TR/css-color-4/#specifying-lab-lch
+	data := []struct {
+		lab, lch string
+		rgb      [3]float64
+	}{
+		{"lab(29.2345% 39.3825 20.0664)", "lch(29.2345% 44.2 27)", [3]float64{49.06, 13.87, 15.9}},
+		{"lab(52.2345% 40.1645 59.9971)", "lch(52.2345% 72.2 56.2)", [3]float64{77.61, 36.34, 2.45}},
+		{"lab(60.2345% -5.3654 58.956)", "lch(60.2345% 59.2 95.2)", [3]float64{61.65, 57.51, 9.28}},
+		{"lab(62.2345% -34.9638 47.7721)", "lch(62.2345% 59.2 126.2)", [3]float64{40.73, 65.12, 22.35}},
+		{"lab(67.5345% -8.6911 -41.6019)", "lch(67.5345% 42.5 258.2)", [3]float64{38.29, 67.27, 93.85}},
+	}
+	for _, d := range data {
+		for _, s := range []string{d.lab, d.lch} {
+			c, err := Parse(s)
+			test(t, err, nil)
+			for i, v := range [3]float64{c.R, c.G, c.B} {
+				if math.Abs(v*100-d.rgb[i]) > 0.02 {
+					t.Errorf("%s: got %v", s, c)
+					break
+				}
+			}
+		}
+	}
+
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) < 0.01
+	}
+	l, a, b, alpha := Color{1, 0, 0, 0.5}.ToLab()
+	testTrue(t, near(l, 54.29) && near(a, 80.80) && near(b, 69.89))
+	test(t, alpha, 0.5)
+	l, a, b, _ = Color{1, 1, 1, 1}.ToLab()
+	testTrue(t, math.Abs(l-100) < 1e-9 && math.Abs(a) < 1e-9 && math.Abs(b) < 1e-9)
+	l, ch, h, _ := Color{0.4, 0.2, 0.6, 1}.ToLch()
+	testTrue(t, near(l, 32.39) && near(ch, 61.24) && near(h*180/math.Pi, 308.86))
+
+	// Round trip
+	for _, c := range []Color{
+		{0.2, 0.4, 0.6, 1},
+		{1, 0.5, 0, 0.25},
+		{0.01, 0, 0.02, 1},
+		{1, 1, 1, 1},
+		// Out of gamut, negative channels keep their sign
+		{-0.2, 0.5, 0.3, 1},
+		{1.2, -0.5, 0, 1},
+	} {
+		for _, x := range []Color{FromLab(c.ToLab()), FromLch(c.ToLch())} {
+			if math.Abs(x.R-c.R) > 1e-9 || math.Abs(x.G-c.G) > 1e-9 || math.Abs(x.B-c.B) > 1e-9 || x.A != c.A {
+				t.Errorf("%v: round trip gives %v", c, x)
+			}
+		}
+	}
+}
+
+func arr4(a, b, c, d float64) [4]float64 {
+	return [4]float64{a, b, c, d}
+}
+
+func Test_ColorFunction(t *testing.T) {
+	data := []struct {
+		s     string
+		rgba8 [4]uint8
+	}{
+		{"color(srgb 1 0.6 0)", [4]uint8{255, 153, 0, 255}},
+		{"color(srgb 100% 60% 0% / 50%)", [4]uint8{255, 153, 0, 128}},
+		{"color(srgb-linear 1 0.5 0)", [4]uint8{255, 188, 0, 255}},
+		{"color(display-p3 1 1 1)", [4]uint8{255, 255, 255, 255}},
+		{"color(a98-rgb 1 1 1)", [4]uint8{255, 255, 255, 255}},
+		{"color(prophoto-rgb 1 1 1)", [4]uint8{255, 255, 255, 255}},
+		{"color(rec2020 1 1 1)", [4]uint8{255, 255, 255, 255}},
+		{"color(rec2020 0.5 0.3 0.8)", [4]uint8{154, 81, 218, 255}},
+		{"color(xyz 0.9505 1 1.089)", [4]uint8{255, 255, 255, 255}},
+		{"color(xyz-d65 0.9505 1 1.089 / 0.25)", [4]uint8{255, 255, 255, 64}},
+		{"color(xyz-d50 0.9642 1 0.8251)", [4]uint8{255, 255, 255, 255}},
+		{"color(xyz-d50 0 0 0)", [4]uint8{0, 0, 0, 255}},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		test(t, arr8(c.RGBA255()), d.rgba8)
+	}
+
+	// Out of sRGB gamut components are preserved
+	c, err := Parse("color(display-p3 1 0 0)")
+	test(t, err, nil)
+	testTrue(t, math.Abs(c.R-1.0930663624) < 1e-9)
+	testTrue(t, math.Abs(c.G+0.2267419735) < 1e-9)
+	testTrue(t, math.Abs(c.B+0.1501345809) < 1e-9)
+	test(t, c.HexString(), "#ff0000")
+	test(t, c.RGBString(), "rgb(255,0,0)")
+	testGoColor(t, c, color.RGBA{255, 0, 0, 255})
+	name, ok := c.Name()
+	testTrue(t, ok)
+	test(t, name, "red")
+	text, err := c.MarshalText()
+	test(t, err, nil)
+	test(t, string(text), "#ff0000")
+	err = c.UnmarshalText([]byte("color(display-p3 0 1 0)"))
+	test(t, err, nil)
+	text, _ = c.MarshalText()
+	test(t, string(text), "#00ff00")
+
+	invalid := []string{
+		"color(srgb 1 0)",
+		"color(srgb 1 0 0 0 0)",
+		"color(display-p4 1 0 0)",
+		"color(xyz-d50 0 x 0)",
+		"color(1 0 0)",
+	}
+	for _, s := range invalid {
+		_, err := Parse(s)
+		testTrue(t, err != nil)
+	}
+}
+
+func Test_NoneKeyword(t *testing.T) {
+	data := []struct {
+		s       string
+		rgba8   [4]uint8
+		missing Missing
+	}{
+		{"rgb(none 128 0)", [4]uint8{0, 128, 0, 255}, Missing0},
+		{"rgb(none 128 0 / none)", [4]uint8{0, 128, 0, 0}, Missing0 | MissingAlpha},
+		{"rgb(255 none none)", [4]uint8{255, 0, 0, 255}, Missing1 | Missing2},
+		{"hsl(none 0% 50%)", [4]uint8{128, 128, 128, 255}, Missing0},
+		{"hwb(none none none)", [4]uint8{255, 0, 0, 255}, Missing0 | Missing1 | Missing2},
+		{"oklch(62.796% none 250)", [4]uint8{136, 136, 136, 255}, Missing1},
+		{"oklab(none 0 0 / 50%)", [4]uint8{0, 0, 0, 128}, Missing0},
+		{"color(srgb 1 none 0)", [4]uint8{255, 0, 0, 255}, Missing1},
+		{"color(display-p3 none 0 0 / none)", [4]uint8{0, 0, 0, 0}, Missing0 | MissingAlpha},
+		{"RGB(NONE 0 0)", [4]uint8{0, 0, 0, 255}, Missing0},
+		{"rgb(0 0 0)", [4]uint8{0, 0, 0, 255}, 0},
+		{"red", [4]uint8{255, 0, 0, 255}, 0},
+	}
+	for _, d := range data {
+		c, missing, err := ParseMissing(d.s)
+		test(t, err, nil)
+		test(t, missing, d.missing)
+		test(t, arr8(c.RGBA255()), d.rgba8)
+	}
+
+	invalid := []string{
+		"none",
+		"rgb(none)",
+		"hsl(nonedeg 50% 50%)",
+		"color(none 1 0 0)",
+	}
+	for _, s := range invalid {
+		_, _, err := ParseMissing(s)
+		testTrue(t, err != nil)
+	}
+}
+
+func Test_MathFunctions(t *testing.T) {
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"rgb(calc(255 / 5) calc(50% + 10%) min(300, 255))", "#3399ff"},
+		{"rgb(clamp(0, 300, 255), 0, 0, calc(1 / 2))", "#ff000080"},
+		{"hsl(calc(120deg + 30deg) 50% 50%)", "#40bf80"},
+		{"hsl(calc(100 + 50) 50% 50%)", "#40bf80"},
+		{"hsl(calc(0.25turn + 60deg) calc(25% * 2) 50%)", "#40bf80"},
+		{"hwb(round(up, 115, 30) 0% 0%)", "#00ff00"},
+		{"oklab(calc(0.5 + 0.12796) calc(0.22486 * 1) 0.12585)", "#ff0000"},
+		{"color(srgb calc(1 / 2 + 0.5) 0 max(0, -1))", "#ff0000"},
+		{"lab(from #7654cd calc(l * 1) a b)", "#7654cd"},
+		{"hsl(from red calc(h * 1deg + 120deg) s l)", "#00ff00"},
+		{"rgb(from red r g b / calc(alpha * 50%))", "#ff000080"},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+	}
+
+	invalid := []string{
+		"rgb(calc(10deg) 0 0)",
+		"rgb(calc(10 + 10%) 0 0)",
+		"hsl(calc(10%) 50% 50%)",
+		"hsl(120 calc(10deg) 50%)",
+		"hsl(calc(1 +) 50% 50%)",
+		"hsl(foo(1) 50% 50%)",
+		"hsl(from red calc(h + 120deg) s l)",
+	}
+	for _, s := range invalid {
+		_, err := Parse(s)
+		testTrue(t, err != nil)
+	}
+}
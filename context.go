@@ -0,0 +1,217 @@
+package csscolorparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ColorScheme is the active color scheme, used by light-dark().
+type ColorScheme int
+
+const (
+	Light ColorScheme = iota
+	Dark
+)
+
+// ParseContext provides the values of context dependent colors and parse options.
+type ParseContext struct {
+	// CurrentColor is the value of the `currentcolor` keyword.
+	// If nil, `currentcolor` can't be resolved.
+	CurrentColor *Color
+
+	// Var returns the value of a custom property, e.g. "--brand".
+	// It returns false if the property is not defined. Parsing fails with
+	// ErrTooComplex after 1024 substitutions or 64 KiB of substituted text.
+	Var func(name string) (string, bool)
+
+	// CMYK converts device-cmyk() components in 0..1 to a Color, e.g. using
+	// a color profile. Alpha is set by the parser. If nil, the fallback color
+	// of device-cmyk() is used, or the naive conversion of FromCMYK.
+	//
+	// As in CSS Color 5, a fallback color takes precedence over the naive
+	// conversion, alpha included: device-cmyk(0 0 0 0 / 50%, red) is red.
+	// To ignore fallback colors, set CMYK to a function calling
+	// FromCMYK(c, m, y, k, 1).
+	CMYK func(c, m, y, k float64) Color
+
+	// ColorScheme selects the first (Light) or second (Dark) color of light-dark(),
+	// and the default system colors.
+	ColorScheme ColorScheme
+
+	// SystemColors overrides the values of system colors. Keywords not in
+	// the table use LightSystemColors or DarkSystemColors.
+	SystemColors SystemColors
+
+	// SystemColorUsed, if not nil, is called with each system color keyword
+	// the color depends on, also inside other colors like rgb(from Canvas r g
+	// b) or color-mix(). The value of such colors depends on the environment.
+	SystemColorUsed func(name string)
+
+	// Registry holds the named colors. If nil, DefaultRegistry is used.
+	Registry *Registry
+
+	// Strict enables strict CSS Color 4 syntax. Hex colors need a '#',
+	// hsv(), hsva() and hwba() are rejected, the comma separated syntax is
+	// only accepted by rgb(), rgba(), hsl() and hsla(), and commas, spaces
+	// and slashes can't be mixed. Plain numbers for hsl() and hwb()
+	// components are on the 0..100 scale, like percentages.
+	Strict bool
+
+	// Profile emulates the color parsing of a browser engine, e.g. Chromium87().
+	Profile *Profile
+}
+
+// ParseWithContext is like Parse, but resolves `currentcolor`, var() and
+// light-dark() using ctx. A nil ctx is the same as an empty ParseContext.
+func ParseWithContext(s string, ctx *ParseContext) (Color, error) {
+	c, _, err := newParser(ctx).parse(s)
+	return c, err
+}
+
+type parser struct {
+	ctx ParseContext
+	// The string being parsed, for error reporting
+	input string
+	// Custom properties being substituted, for cycle detection, and the
+	// number and total length of substitutions so far
+	resolving     map[string]bool
+	substitutions int
+	substituted   int
+	// Nesting level of parseColor, and the value of the outermost color
+	// function, for ParseValue
+	depth int
+	value *Value
+}
+
+func newParser(ctx *ParseContext) *parser {
+	p := &parser{resolving: map[string]bool{}}
+	if ctx != nil {
+		p.ctx = *ctx
+	}
+	return p
+}
+
+func (p *parser) currentColor() (Color, bool) {
+	if p.ctx.CurrentColor == nil {
+		return black, false
+	}
+	return *p.ctx.CurrentColor, true
+}
+
+// light-dark(<color>, <color>)
+func (p *parser) lightDark(s string, offset int) (Color, Missing, error) {
+	args, offsets := splitComma(s)
+	if len(args) != 2 {
+		return black, 0, p.failf(ErrWrongArity, offset, s, "light-dark() expects 2 colors, got %d", len(args))
+	}
+	if p.ctx.ColorScheme == Dark {
+		return p.parseColor(args[1], offset+offsets[1])
+	}
+	return p.parseColor(args[0], offset+offsets[0])
+}
+
+// asciiLower is like strings.ToLower, but keeps byte offsets intact.
+func asciiLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if c >= 'A' && c <= 'Z' {
+			b[i] = c + 32
+		}
+	}
+	return string(b)
+}
+
+// Limits of var() substitution. Like in browsers, they stop custom
+// properties that expand exponentially, e.g. --a: var(--b) var(--b).
+const (
+	maxVarSubstitutions = 1024
+	maxVarLength        = 1 << 16
+)
+
+// substituteVars replaces every var(--name, fallback) in s with the value
+// of the custom property, or the fallback if the property is not defined.
+// https://www.w3.org/TR/css-variables-1/#using-variables
+func (p *parser) substituteVars(s string) (string, error) {
+	var sb strings.Builder
+	lower := asciiLower(s)
+	pos := 0
+	for {
+		i := strings.Index(lower[pos:], "var(")
+		if i == -1 {
+			sb.WriteString(s[pos:])
+			return sb.String(), nil
+		}
+		i += pos
+		sb.WriteString(s[pos:i])
+
+		// Find the matching parenthesis
+		depth := 0
+		end := -1
+		for j := i + 3; j < len(s) && end == -1; j++ {
+			switch s[j] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth == 0 {
+					end = j
+				}
+			}
+		}
+		if end == -1 {
+			return s, &ParseError{Kind: ErrInvalidFormat, Input: s, Offset: i, Token: s[i:], Detail: "missing ')'"}
+		}
+		token := s[i : end+1]
+
+		args := s[i+4 : end]
+		name := args
+		fallback, fallbackOffset := "", -1
+		if c := strings.Index(args, ","); c != -1 {
+			name, fallback, fallbackOffset = args[:c], args[c+1:], i+4+c+1
+		}
+		name = strings.TrimSpace(name)
+		if !strings.HasPrefix(name, "--") {
+			return s, &ParseError{Kind: ErrInvalidFormat, Input: s, Offset: i, Token: token, Detail: "invalid custom property name"}
+		}
+
+		value, err := p.resolveVar(name)
+		if err != nil {
+			e := err.(*ParseError)
+			// Properties taking part in a cycle are invalid, even with a fallback.
+			if fallbackOffset == -1 || (e.Kind == ErrCyclicVar && p.resolving[e.prop]) || e.Kind == ErrTooComplex {
+				return s, &ParseError{Kind: e.Kind, Input: s, Offset: i, Token: token, Detail: e.Detail, prop: e.prop}
+			}
+			if value, err = p.substituteVars(fallback); err != nil {
+				e := *err.(*ParseError)
+				e.Input = s
+				e.Offset += fallbackOffset
+				return s, &e
+			}
+		}
+		if p.substituted += len(value); p.substituted > maxVarLength {
+			return s, &ParseError{Kind: ErrTooComplex, Input: s, Offset: i, Token: token, Detail: fmt.Sprintf("var() substitutes more than %d bytes", maxVarLength)}
+		}
+		sb.WriteString(strings.TrimSpace(value))
+		pos = end + 1
+	}
+}
+
+func (p *parser) resolveVar(name string) (string, error) {
+	if p.resolving[name] {
+		return "", &ParseError{Kind: ErrCyclicVar, Token: name, Detail: name + " depends on itself", prop: name}
+	}
+	var value string
+	ok := false
+	if p.ctx.Var != nil {
+		value, ok = p.ctx.Var(name)
+	}
+	if !ok {
+		return "", &ParseError{Kind: ErrUndefinedVar, Token: name, Detail: name + " is not defined"}
+	}
+	if p.substitutions++; p.substitutions > maxVarSubstitutions {
+		return "", &ParseError{Kind: ErrTooComplex, Token: name, Detail: fmt.Sprintf("more than %d var() substitutions", maxVarSubstitutions)}
+	}
+	p.resolving[name] = true
+	defer delete(p.resolving, name)
+	return p.substituteVars(value)
+}
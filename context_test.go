@@ -0,0 +1,117 @@
+package csscolorparser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ParseWithContext(t *testing.T) {
+	vars := map[string]string{
+		"--brand":   "#7654cd",
+		"--Brand":   "gold",
+		"--alias":   "var(--brand)",
+		"--red":     "255",
+		"--pct":     "50%",
+		"--cycle-a": "var(--cycle-b)",
+		"--cycle-b": "var(--cycle-a)",
+		"--self":    "var(--self, red)",
+	}
+	current := Color{0, 0, 1, 1}
+	ctx := &ParseContext{
+		CurrentColor: &current,
+		Var: func(name string) (string, bool) {
+			v, ok := vars[name]
+			return v, ok
+		},
+	}
+
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"currentcolor", "#0000ff"},
+		{"CurrentColor", "#0000ff"},
+		{"var(--brand)", "#7654cd"},
+		{"var(--Brand)", "#ffd700"},
+		{"VAR( --alias )", "#7654cd"},
+		{"rgb(var(--red) 0 0)", "#ff0000"},
+		{"rgb(var(--red) 0 0 / var(--pct))", "#ff000080"},
+		{"var(--undefined, lime)", "#00ff00"},
+		{"var(--undefined, var(--alias))", "#7654cd"},
+		{"var(--undefined,)red", "#ff0000"},
+		{"var(--cycle-a, red)", "#ff0000"},
+		{"oklch(from var(--brand) l c h)", "#7654cd"},
+		{"rgb(from currentcolor r g b / 50%)", "#0000ff80"},
+		{"color-mix(in srgb-linear, currentcolor, var(--undefined, red))", "#bc00bc"},
+		{"light-dark(white, black)", "#ffffff"},
+		{"light-dark(var(--brand), currentcolor)", "#7654cd"},
+	}
+	for _, d := range data {
+		c, err := ParseWithContext(d.s, ctx)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+	}
+
+	dark := *ctx
+	dark.ColorScheme = Dark
+	c, err := ParseWithContext("light-dark(white, light-dark(red, currentcolor))", &dark)
+	test(t, err, nil)
+	test(t, c.HexString(), "#0000ff")
+
+	invalid := []string{
+		"var(--undefined)",
+		"var(--cycle-a)",
+		"var(--self)",
+		"var(brand)",
+		"var(--brand",
+		"rgb(var(--pct) var(--undefined) 0)",
+		"light-dark(white)",
+		"light-dark(white, black, red)",
+	}
+	for _, s := range invalid {
+		_, err := ParseWithContext(s, ctx)
+		testTrue(t, err != nil)
+	}
+
+	// Without context
+	c, err = ParseWithContext("light-dark(white, black)", nil)
+	test(t, err, nil)
+	test(t, c.HexString(), "#ffffff")
+
+	for _, s := range []string{"currentcolor", "var(--brand)", "rgb(from currentcolor r g b)"} {
+		_, err := Parse(s)
+		testTrue(t, err != nil)
+		_, err = ParseWithContext(s, nil)
+		testTrue(t, err != nil)
+	}
+	_, err = Parse("var(--brand, red)")
+	test(t, err, nil)
+}
+
+func Test_VarLimits(t *testing.T) {
+	// Each level doubles the length of the substitution
+	vars := map[string]string{"--l30": "0"}
+	for i := 0; i < 30; i++ {
+		vars[fmt.Sprintf("--l%d", i)] = fmt.Sprintf("var(--l%d) var(--l%d)", i+1, i+1)
+	}
+	vars["--long"] = strings.Repeat(" ", maxVarLength) + "red"
+	ctx := &ParseContext{Var: func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}}
+
+	for _, s := range []string{"var(--l0)", "var(--l0, red)", "rgb(var(--l20))", "var(--long)"} {
+		start := time.Now()
+		_, err := ParseWithContext(s, ctx)
+		testTrue(t, errors.Is(err, ErrTooComplex))
+		testTrue(t, time.Since(start) < time.Second)
+	}
+
+	// A few levels are fine
+	c, err := ParseWithContext("rgb(var(--l29) 0)", ctx)
+	test(t, err, nil)
+	test(t, c.HexString(), "#000000")
+}
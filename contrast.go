@@ -0,0 +1,132 @@
+package csscolorparser
+
+import "strings"
+
+// Luminance returns the relative luminance of the color, from 0 for black
+// to 1 for white. Alpha is ignored.
+// https://www.w3.org/TR/WCAG21/#dfn-relative-luminance
+func (c Color) Luminance() float64 {
+	c = c.Clamp()
+	return 0.2126*toLinear(c.R) + 0.7152*toLinear(c.G) + 0.0722*toLinear(c.B)
+}
+
+// Contrast returns the WCAG 2.1 contrast ratio between two colors, from 1 to 21.
+// https://www.w3.org/TR/WCAG21/#dfn-contrast-ratio
+func (c Color) Contrast(other Color) float64 {
+	l1, l2 := c.Luminance(), other.Luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+var white = Color{1, 1, 1, 1}
+
+// Contrast targets of color-contrast()
+var contrastTargets = map[string]float64{
+	"aa":        4.5,
+	"aa-large":  3,
+	"aaa":       7,
+	"aaa-large": 4.5,
+}
+
+// maxContrast returns white or black, whichever has the higher contrast
+// with c. White wins ties.
+func maxContrast(c Color) Color {
+	if c.Contrast(black) > c.Contrast(white) {
+		return black
+	}
+	return white
+}
+
+// contrast-color(<color>)
+// https://www.w3.org/TR/css-color-5/#contrast-color
+func (p *parser) contrastColor(s string, offset int) (Color, Missing, error) {
+	args, offsets := splitComma(s)
+	if len(args) != 1 {
+		return black, 0, p.failf(ErrWrongArity, offset, s, "contrast-color() expects 1 color, got %d", len(args))
+	}
+	c, _, err := p.parseColor(args[0], offset+offsets[0])
+	if err != nil {
+		return black, 0, err
+	}
+	return maxContrast(c), 0, nil
+}
+
+// color-contrast(<color> vs <color>#{2,} [to [<number> | AA | AA-large | AAA | AAA-large]]?)
+// returns the color of the list with the highest contrast, or with a target,
+// the first color that meets it. If none does, white or black is returned.
+// https://www.w3.org/TR/2021/WD-css-color-5-20210601/#colorcontrast
+func (p *parser) colorContrast(s string, offset int) (Color, Missing, error) {
+	vs := keywordIndex(s, "vs")
+	if vs == -1 {
+		return black, 0, p.failf(ErrInvalidFormat, offset, s, "expected 'vs'")
+	}
+	bg, _, err := p.parseColor(s[:vs], offset)
+	if err != nil {
+		return black, 0, err
+	}
+
+	list := s[vs+2:]
+	listOffset := offset + vs + 2
+	target := -1.0
+	if to := keywordIndex(list, "to"); to != -1 {
+		tok := strings.TrimSpace(list[to+2:])
+		tokOffset := listOffset + to + 2 + strings.Index(list[to+2:], tok)
+		var ok bool
+		if target, ok = contrastTargets[tok]; !ok {
+			if target, ok = parseFloat(tok); !ok {
+				return black, 0, p.failf(ErrBadNumber, tokOffset, tok, "expected a number, AA, AA-large, AAA or AAA-large")
+			}
+			if target < 1 || target > 21 {
+				return black, 0, p.failf(ErrOutOfRange, tokOffset, tok, "contrast must be between 1 and 21")
+			}
+		}
+		list = list[:to]
+	}
+
+	args, offsets := splitComma(list)
+	if len(args) < 2 {
+		return black, 0, p.failf(ErrWrongArity, listOffset, list, "color-contrast() expects at least 2 colors to compare, got %d", len(args))
+	}
+	var best Color
+	bestContrast := -1.0
+	for i, arg := range args {
+		c, _, err := p.parseColor(arg, listOffset+offsets[i])
+		if err != nil {
+			return black, 0, err
+		}
+		contrast := bg.Contrast(c)
+		if target >= 0 && contrast >= target {
+			return c, 0, nil
+		}
+		if contrast > bestContrast {
+			best, bestContrast = c, contrast
+		}
+	}
+	if target >= 0 {
+		return maxContrast(bg), 0, nil
+	}
+	return best, 0, nil
+}
+
+// keywordIndex returns the index of the first keyword kw in s, outside
+// parentheses and surrounded by whitespace, or -1.
+func keywordIndex(s, kw string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		default:
+			if depth == 0 && strings.HasPrefix(s[i:], kw) &&
+				i > 0 && isSpace(s[i-1]) &&
+				i+len(kw) < len(s) && isSpace(s[i+len(kw)]) {
+				return i
+			}
+		}
+	}
+	return -1
+}
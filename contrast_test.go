@@ -0,0 +1,61 @@
+package csscolorparser
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func Test_Contrast(t *testing.T) {
+	test(t, black.Luminance(), 0.0)
+	test(t, white.Luminance(), 1.0)
+	test(t, black.Contrast(white), 21.0)
+	test(t, white.Contrast(black), 21.0)
+	test(t, white.Contrast(white), 1.0)
+
+	c, _ := Parse("#777")
+	testTrue(t, math.Abs(c.Contrast(white)-4.48) < 0.01)
+
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"contrast-color(white)", "#000000"},
+		{"contrast-color(black)", "#ffffff"},
+		{"contrast-color(#777)", "#000000"},
+		{"contrast-color(navy)", "#ffffff"},
+		{"contrast-color(yellow)", "#000000"},
+		{"contrast-color(rgb(from navy r g b / 50%))", "#ffffff"},
+		{"rgb(from contrast-color(gold) r g b / 0.5)", "#00000080"},
+		{"color-contrast(wheat vs tan, sienna, #d2691e)", "#a0522d"},
+		{"color-contrast(wheat vs bisque, darkgoldenrod, olive, sienna, darkgreen, maroon to AA)", "#006400"},
+		{"color-contrast(wheat vs bisque, darkgoldenrod, olive to 3)", "#808000"},
+		{"color-contrast(wheat vs bisque, darkgoldenrod, olive to aaa-large)", "#000000"},
+		{"color-contrast(rgb(0 0 0 / 1) vs rgb(10 10 10), hsl(0 0% 90%))", "#e6e6e6"},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+	}
+
+	invalid := []struct {
+		s    string
+		kind error
+	}{
+		{"contrast-color()", ErrInvalidFormat},
+		{"contrast-color(red, blue)", ErrWrongArity},
+		{"contrast-color(bloodred)", ErrUnknownName},
+		{"color-contrast(wheat tan, sienna)", ErrInvalidFormat},
+		{"color-contrast(wheat vs tan)", ErrWrongArity},
+		{"color-contrast(wheat vs tan, sienna to AAAA)", ErrBadNumber},
+		{"color-contrast(wheat vs tan, sienna to 22)", ErrOutOfRange},
+	}
+	for _, d := range invalid {
+		c, err := Parse(d.s)
+		testColor(t, c, Color{A: 1})
+		if !errors.Is(err, d.kind) {
+			t.Errorf("%q: expected %v, got %v", d.s, d.kind, err)
+		}
+	}
+}
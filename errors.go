@@ -0,0 +1,92 @@
+package csscolorparser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Kinds of parse errors. Every error returned by the parse functions is a
+// *ParseError, whose Kind is one of these values:
+//
+//	if errors.Is(err, csscolorparser.ErrBadNumber) { ... }
+var (
+	ErrInvalidFormat     = errors.New("invalid color format")
+	ErrUnknownName       = errors.New("unknown color name")
+	ErrUnknownFunction   = errors.New("unknown function")
+	ErrUnknownColorSpace = errors.New("unknown color space")
+	ErrWrongArity        = errors.New("wrong number of components")
+	ErrBadNumber         = errors.New("invalid number")
+	ErrBadUnit           = errors.New("invalid unit")
+	ErrOutOfRange        = errors.New("value out of range")
+	ErrBadHexLength      = errors.New("invalid hex color length")
+	ErrBadHexDigit       = errors.New("invalid hex digit")
+	ErrBadMath           = errors.New("invalid math function")
+	ErrUndefinedVar      = errors.New("undefined custom property")
+	ErrCyclicVar         = errors.New("cyclic custom property")
+	ErrNoCurrentColor    = errors.New("currentcolor is not available")
+	ErrTooComplex        = errors.New("input too complex")
+)
+
+// ParseError describes why and where parsing failed.
+type ParseError struct {
+	Kind   error  // One of the Err* values
+	Input  string // The string being parsed, after var() substitution
+	Offset int    // Byte offset of Token in Input
+	Token  string // The offending token
+	Detail string // Optional description
+
+	// Suggestions are the closest color names to an unknown name, best first.
+	Suggestions []string
+
+	prop string // Custom property name, for cycle detection
+}
+
+func (e *ParseError) Error() string {
+	s := fmt.Sprintf("%v %q at offset %d in %q", e.Kind, e.Token, e.Offset, e.Input)
+	if e.Detail != "" {
+		s += ": " + e.Detail
+	}
+	if len(e.Suggestions) > 0 {
+		s += fmt.Sprintf("; did you mean %q?", e.Suggestions[0])
+		if len(e.Suggestions) > 1 {
+			s = s[:len(s)-1] + fmt.Sprintf(" (or %s)?", quoteList(e.Suggestions[1:]))
+		}
+	}
+	return s
+}
+
+func quoteList(a []string) string {
+	q := make([]string, len(a))
+	for i, s := range a {
+		q[i] = strconv.Quote(s)
+	}
+	return strings.Join(q, ", ")
+}
+
+// Unwrap returns e.Kind.
+func (e *ParseError) Unwrap() error {
+	return e.Kind
+}
+
+func (p *parser) fail(kind error, offset int, token string) *ParseError {
+	return &ParseError{Kind: kind, Input: p.input, Offset: offset, Token: token}
+}
+
+func (p *parser) failf(kind error, offset int, token string, format string, a ...interface{}) *ParseError {
+	e := p.fail(kind, offset, token)
+	e.Detail = fmt.Sprintf(format, a...)
+	return e
+}
+
+// at moves an error returned for a token that starts at offset in p.input.
+func (p *parser) at(err error, offset int) error {
+	if e, ok := err.(*ParseError); ok {
+		e2 := *e
+		e2.Input = p.input
+		e2.Offset += offset
+		return &e2
+	}
+	return err
+}
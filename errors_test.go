@@ -0,0 +1,79 @@
+package csscolorparser
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ParseError(t *testing.T) {
+	data := []struct {
+		s      string
+		kind   error
+		offset int
+		token  string
+	}{
+		{"", ErrInvalidFormat, 0, ""},
+		{"bloodred", ErrUnknownName, 0, "bloodred"},
+		{"  bloodred", ErrUnknownName, 2, "bloodred"},
+		{"#78afzd", ErrBadHexDigit, 5, "z"},
+		{"#fffff", ErrBadHexLength, 0, "#fffff"},
+		{"rgb(0,255,8s)", ErrBadUnit, 11, "s"},
+		{"rgb(100%,z9%,75%)", ErrBadNumber, 9, "z9%"},
+		{"cmyk(1 0 0)", ErrUnknownFunction, 0, "cmyk"},
+		{"rgba(0 0)", ErrWrongArity, 0, "rgba(0 0)"},
+		{"hsl(180deg 1 x%)", ErrBadNumber, 13, "x%"},
+		{"rgb(10deg 0 0)", ErrBadUnit, 6, "deg"},
+		{"color(display-p4 1 0 0)", ErrUnknownColorSpace, 6, "display-p4"},
+		{"rgb(calc(1 + 5%) 0 0)", ErrBadUnit, 9, "1 + 5%"},
+		{"color-mix(in srgb, red 120%, blue)", ErrOutOfRange, 23, "120%"},
+		{"color-mix(in hsv, red, blue)", ErrUnknownColorSpace, 13, "hsv"},
+		{"color-mix(in srgb, red, bloodred)", ErrUnknownName, 24, "bloodred"},
+		{"rgb(from bloodred r g b)", ErrUnknownName, 9, "bloodred"},
+		{"currentcolor", ErrNoCurrentColor, 0, "currentcolor"},
+		{"var(--x)", ErrUndefinedVar, 0, "var(--x)"},
+		{"light-dark(red)", ErrWrongArity, 11, "red"},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		testColor(t, c, Color{A: 1})
+		var e *ParseError
+		if !errors.As(err, &e) {
+			t.Errorf("%q: expected *ParseError, got %v", d.s, err)
+			continue
+		}
+		if !errors.Is(err, d.kind) {
+			t.Errorf("%q: expected %v, got %v", d.s, d.kind, e.Kind)
+		}
+		if e.Offset != d.offset || e.Token != d.token {
+			t.Errorf("%q: expected %q at %d, got %q at %d", d.s, d.token, d.offset, e.Token, e.Offset)
+		}
+	}
+
+	vars := map[string]string{
+		"--a": "var(--b)",
+		"--b": "var(--a)",
+		"--c": "rgb(0 0 0 / 5deg)",
+	}
+	ctx := &ParseContext{Var: func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}}
+
+	_, err := ParseWithContext("rgb(0 0 0 / var(--a))", ctx)
+	testTrue(t, errors.Is(err, ErrCyclicVar))
+	test(t, err.(*ParseError).Offset, 12)
+
+	_, err = ParseWithContext("var(--x, var(--y))", ctx)
+	testTrue(t, errors.Is(err, ErrUndefinedVar))
+	test(t, err.(*ParseError).Offset, 9)
+	test(t, err.(*ParseError).Token, "var(--y)")
+
+	// Errors after substitution refer to the substituted string
+	_, err = ParseWithContext("var(--c)", ctx)
+	testTrue(t, errors.Is(err, ErrBadUnit))
+	test(t, err.(*ParseError).Input, "rgb(0 0 0 / 5deg)")
+	test(t, err.(*ParseError).Token, "deg")
+
+	test(t, (&ParseError{Kind: ErrBadUnit, Input: "rgb(0,255,8s)", Offset: 11, Token: "s"}).Error(),
+		`invalid unit "s" at offset 11 in "rgb(0,255,8s)"`)
+}
@@ -41,3 +41,29 @@ func Example_rgbColor() {
 	// #ff000080
 	// rgba(255,0,0,0.5)
 }
+
+func ExampleParseWithContext() {
+	current := csscolorparser.Color{R: 0, G: 0, B: 1, A: 1}
+	ctx := &csscolorparser.ParseContext{
+		CurrentColor: &current,
+		Var: func(name string) (string, bool) {
+			if name == "--brand" {
+				return "#7654cd", true
+			}
+			return "", false
+		},
+		ColorScheme: csscolorparser.Dark,
+	}
+
+	for _, s := range []string{"currentcolor", "var(--brand)", "light-dark(white, var(--brand))"} {
+		c, err := csscolorparser.ParseWithContext(s, ctx)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(c.HexString())
+	}
+	// Output:
+	// #0000ff
+	// #7654cd
+	// #7654cd
+}
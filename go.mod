@@ -1,3 +1,3 @@
 module github.com/mazznoer/csscolorparser
 
-go 1.12
+go 1.13
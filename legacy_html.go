@@ -0,0 +1,100 @@
+package csscolorparser
+
+import "strings"
+
+// ParseLegacyHTML parses the value of a legacy HTML color attribute such as
+// bgcolor="chucknorris", using the rules for parsing a legacy color value.
+// Any string gives a color, except the empty string or "transparent".
+// https://html.spec.whatwg.org/multipage/common-microsyntaxes.html#rules-for-parsing-a-legacy-colour-value
+func ParseLegacyHTML(s string) (Color, error) {
+	if s == "" {
+		return black, &ParseError{Kind: ErrInvalidFormat, Detail: "empty string"}
+	}
+	// Whitespace is stripped after the empty check: whitespace only
+	// strings are black.
+	input := s
+	s = strings.Trim(s, " \t\n\r\f")
+
+	lower := asciiLower(s)
+	if lower == "transparent" {
+		return black, &ParseError{Kind: ErrInvalidFormat, Input: input, Offset: strings.Index(input, s), Token: s}
+	}
+	if c, ok := namedColors[lower]; ok {
+		return Color{float64(c[0]) / 255, float64(c[1]) / 255, float64(c[2]) / 255, 1}, nil
+	}
+
+	if len(lower) == 4 && lower[0] == '#' && isHex(lower[1]) && isHex(lower[2]) && isHex(lower[3]) {
+		c, _ := parseHex(lower[1:])
+		return c, nil
+	}
+
+	// Code points outside the BMP are replaced with "00", the result is
+	// truncated to 128 code points, then any character that is not a hex
+	// digit is replaced with '0'.
+	var digits []byte
+	for _, r := range lower {
+		switch {
+		case r > 0xffff:
+			digits = append(digits, '0', '0')
+		case r < 0x80:
+			digits = append(digits, byte(r))
+		default:
+			digits = append(digits, 'x')
+		}
+	}
+	if len(digits) > 128 {
+		digits = digits[:128]
+	}
+	if len(digits) > 0 && digits[0] == '#' {
+		digits = digits[1:]
+	}
+	for i, c := range digits {
+		if !isHex(c) {
+			digits[i] = '0'
+		}
+	}
+	for len(digits) == 0 || len(digits)%3 != 0 {
+		digits = append(digits, '0')
+	}
+
+	n := len(digits) / 3
+	comp := [3][]byte{digits[:n], digits[n : 2*n], digits[2*n:]}
+	if n > 8 {
+		for i := range comp {
+			comp[i] = comp[i][n-8:]
+		}
+		n = 8
+	}
+	for n > 2 && comp[0][0] == '0' && comp[1][0] == '0' && comp[2][0] == '0' {
+		for i := range comp {
+			comp[i] = comp[i][1:]
+		}
+		n--
+	}
+	if n > 2 {
+		for i := range comp {
+			comp[i] = comp[i][:2]
+		}
+	}
+
+	var v [3]float64
+	for i, d := range comp {
+		x := 0
+		for _, b := range d {
+			x = x<<4 | hexValue(b)
+		}
+		v[i] = float64(x) / 255
+	}
+	return Color{v[0], v[1], v[2], 1}, nil
+}
+
+func isHex(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'f')
+}
+
+func hexValue(c byte) int {
+	if isDigit(c) {
+		return int(c - '0')
+	}
+	return int(c-'a') + 10
+}
@@ -0,0 +1,58 @@
+package csscolorparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_ParseLegacyHTML(t *testing.T) {
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"red", "#ff0000"},
+		{" RebeccaPurple\n", "#663399"},
+		{"#ABC", "#aabbcc"},
+		{"#abcd", "#abcd00"},
+		{"abc", "#0a0b0c"},
+		{"fff", "#0f0f0f"},
+		{"#fff", "#ffffff"},
+		{"ffffff", "#ffffff"},
+		{"#ff000080", "#ff0080"},
+		{"#zz0", "#000000"},
+		{"chucknorris", "#c00000"},
+		{"sick", "#00c000"},
+		{"crap", "#c0a000"},
+		{"#", "#000000"},
+		{"#1", "#010000"},
+		{"currentcolor", "#c0e000"},
+		{"canvas", "#ca00a0"},
+		{"rgb(255,0,0)", "#002500"},
+		{"#123456789abcdef012", "#1278de"},
+		{"000000fff000000fff000000fff", "#ffffff"},
+		{"\U0001F600", "#000000"},
+		{"\U0001F600ffff", "#00ffff"},
+		{"é1é2é3", "#010203"},
+		{"   ", "#000000"},
+		{"\t", "#000000"},
+	}
+	for _, d := range data {
+		c, err := ParseLegacyHTML(d.s)
+		test(t, err, nil)
+		if c.HexString() != d.hex {
+			t.Errorf("%q: expected %s, got %s", d.s, d.hex, c.HexString())
+		}
+	}
+
+	// Only the first 128 code points are used
+	c, err := ParseLegacyHTML("#" + strings.Repeat("0", 127) + "fff")
+	test(t, err, nil)
+	test(t, c.HexString(), "#000000")
+
+	for _, s := range []string{"", "transparent", "Transparent", " transparent\n"} {
+		c, err := ParseLegacyHTML(s)
+		testTrue(t, errors.Is(err, ErrInvalidFormat))
+		testColor(t, c, Color{A: 1})
+	}
+}
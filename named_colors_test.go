@@ -73,3 +73,37 @@ func Test_NamedColors(t *testing.T) {
 		test(t, name, "")
 	}
 }
+
+func Test_NameAliases(t *testing.T) {
+	data := []struct {
+		hex   string
+		names []string
+	}{
+		{"#00ffff", []string{"aqua", "cyan"}},
+		{"#ff00ff", []string{"fuchsia", "magenta"}},
+		{"#808080", []string{"gray", "grey"}},
+		{"#2f4f4f", []string{"darkslategray", "darkslategrey"}},
+		{"#d3d3d3", []string{"lightgray", "lightgrey"}},
+		{"#ff0000", []string{"red"}},
+		{"#123456", nil},
+	}
+	for _, d := range data {
+		c, _ := Parse(d.hex)
+		for i := 0; i < 10; i++ {
+			test(t, strings.Join(c.Names(), " "), strings.Join(d.names, " "))
+			name, ok := c.Name()
+			test(t, ok, d.names != nil)
+			if ok {
+				test(t, name, d.names[0])
+			}
+		}
+	}
+
+	// Every named color has a name
+	for name := range namedColors {
+		c, _ := Parse(name)
+		names := c.Names()
+		testTrue(t, len(names) > 0)
+		testTrue(t, strings.Contains(strings.Join(names, " "), name))
+	}
+}
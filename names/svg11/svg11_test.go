@@ -0,0 +1,23 @@
+package svg11
+
+import (
+	"testing"
+
+	"github.com/mazznoer/csscolorparser"
+)
+
+func TestColors(t *testing.T) {
+	colors := Colors()
+	css := csscolorparser.CSSColors()
+	if len(colors) != 147 {
+		t.Fatalf("got %d colors", len(colors))
+	}
+	for name, c := range colors {
+		if css[name] != c {
+			t.Errorf("%s: %v, CSS %v", name, c, css[name])
+		}
+	}
+	if _, ok := colors["rebeccapurple"]; ok {
+		t.Error("rebeccapurple is not an SVG 1.1 color")
+	}
+}
@@ -0,0 +1,47 @@
+package x11
+
+import (
+	"testing"
+
+	"github.com/mazznoer/csscolorparser"
+)
+
+func TestColors(t *testing.T) {
+	colors := Colors()
+	if len(colors) != 658 {
+		t.Fatalf("got %d colors", len(colors))
+	}
+
+	// X11 takes precedence over CSS
+	r, err := csscolorparser.NewRegistryFrom(csscolorparser.CSSColors(), colors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := &csscolorparser.ParseContext{Registry: r}
+	data := [][2]string{
+		{"DarkSeaGreen3", "#9bcd9b"},
+		{"LightGoldenrod", "#eedd82"},
+		{"gray42", "#6b6b6b"},
+		{"grey100", "#ffffff"},
+		{"gray", "#bebebe"},
+		{"green", "#00ff00"},
+		{"rebeccapurple", "#663399"},
+	}
+	for _, d := range data {
+		c, err := csscolorparser.ParseWithContext(d[0], ctx)
+		if err != nil {
+			t.Errorf("%s: %v", d[0], err)
+			continue
+		}
+		if c.HexString() != d[1] {
+			t.Errorf("%s: expected %s, got %s", d[0], d[1], c.HexString())
+		}
+	}
+
+	// CSS takes precedence over X11
+	r, _ = csscolorparser.NewRegistryFrom(colors, csscolorparser.CSSColors())
+	c, _ := csscolorparser.ParseWithContext("gray", &csscolorparser.ParseContext{Registry: r})
+	if c.HexString() != "#808080" {
+		t.Errorf("gray: got %s", c.HexString())
+	}
+}
@@ -0,0 +1,1010 @@
+// Package xkcd provides the color names of the xkcd color survey, from
+// https://xkcd.com/color/rgb.txt. The survey results are public domain (CC0).
+//
+//	r, _ := csscolorparser.NewRegistryFrom(csscolorparser.CSSColors(), xkcd.Colors())
+//	c, err := csscolorparser.ParseWithContext("PukeGreen", &csscolorparser.ParseContext{Registry: r})
+//
+// Names are lowercase without spaces and apostrophes, with '/' replaced by
+// '-', e.g. "robinseggblue" for "robin's egg blue" and "blue-green" for
+// "blue/green". When two survey names give the same name, like "blue green"
+// and "bluegreen", the later one in rgb.txt wins, as with Parse.
+// xkcd disagrees with CSS on many colors, e.g. green and gray.
+package xkcd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mazznoer/csscolorparser"
+)
+
+// Colors returns the xkcd named colors.
+func Colors() map[string]csscolorparser.Color {
+	res := make(map[string]csscolorparser.Color, len(colors))
+	for name, c := range colors {
+		res[name] = csscolorparser.Color{R: float64(c[0]) / 255, G: float64(c[1]) / 255, B: float64(c[2]) / 255, A: 1}
+	}
+	return res
+}
+
+// Parse reads the colors of the survey in the format of rgb.txt: one
+// "name<TAB>#rrggbb" per line. Empty lines and the license line are skipped.
+func Parse(r io.Reader) (map[string]csscolorparser.Color, error) {
+	colors := map[string]csscolorparser.Color{}
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasPrefix(s, "License:") {
+			continue
+		}
+		i := strings.LastIndex(s, "#")
+		if i == -1 {
+			return nil, fmt.Errorf("xkcd: line %d: missing color", line)
+		}
+		name := Name(s[:i])
+		c, err := csscolorparser.Parse(s[i:])
+		if err != nil || name == "" {
+			return nil, fmt.Errorf("xkcd: line %d: invalid color %q", line, s)
+		}
+		colors[name] = c
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return colors, nil
+}
+
+// Name returns the registry name of a survey color name,
+// e.g. "robinseggblue" for "robin's egg blue".
+func Name(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		case r == '/' || r == '-':
+			return '-'
+		}
+		return -1
+	}, strings.TrimSpace(s))
+}
+
+var colors = map[string][3]uint8{
+	"acidgreen":             {143, 254, 9},
+	"adobe":                 {189, 108, 72},
+	"algae":                 {84, 172, 104},
+	"algaegreen":            {33, 195, 111},
+	"almostblack":           {7, 13, 13},
+	"amber":                 {254, 179, 8},
+	"amethyst":              {155, 95, 192},
+	"apple":                 {110, 203, 60},
+	"applegreen":            {118, 205, 38},
+	"apricot":               {255, 177, 109},
+	"aqua":                  {19, 234, 201},
+	"aquablue":              {2, 216, 233},
+	"aquagreen":             {18, 225, 147},
+	"aquamarine":            {4, 216, 178},
+	"armygreen":             {75, 93, 22},
+	"asparagus":             {119, 171, 86},
+	"aubergine":             {61, 7, 52},
+	"auburn":                {154, 48, 1},
+	"avocado":               {144, 177, 52},
+	"avocadogreen":          {135, 169, 34},
+	"azul":                  {29, 93, 236},
+	"azure":                 {6, 154, 243},
+	"babyblue":              {162, 207, 254},
+	"babygreen":             {140, 255, 158},
+	"babypink":              {255, 183, 206},
+	"babypoo":               {171, 144, 4},
+	"babypoop":              {147, 124, 0},
+	"babypoopgreen":         {143, 152, 5},
+	"babypukegreen":         {182, 196, 6},
+	"babypurple":            {202, 155, 247},
+	"babyshitbrown":         {173, 144, 13},
+	"babyshitgreen":         {136, 151, 23},
+	"banana":                {255, 255, 126},
+	"bananayellow":          {250, 254, 75},
+	"barbiepink":            {254, 70, 165},
+	"barfgreen":             {148, 172, 2},
+	"barney":                {172, 29, 184},
+	"barneypurple":          {160, 4, 152},
+	"battleshipgrey":        {107, 124, 133},
+	"beige":                 {230, 218, 166},
+	"berry":                 {153, 15, 75},
+	"bile":                  {181, 195, 6},
+	"black":                 {0, 0, 0},
+	"bland":                 {175, 168, 139},
+	"blood":                 {119, 0, 1},
+	"bloodorange":           {254, 75, 3},
+	"bloodred":              {152, 0, 2},
+	"blue":                  {3, 67, 223},
+	"blue-green":            {15, 155, 142},
+	"blue-grey":             {117, 141, 163},
+	"blue-purple":           {90, 6, 239},
+	"blueberry":             {70, 65, 150},
+	"blueblue":              {34, 66, 199},
+	"bluegreen":             {19, 126, 109},
+	"bluegrey":              {96, 124, 142},
+	"bluepurple":            {87, 41, 206},
+	"blueviolet":            {93, 6, 233},
+	"bluewithahintofpurple": {83, 60, 198},
+	"blueygreen":            {43, 177, 121},
+	"blueygrey":             {137, 160, 176},
+	"blueypurple":           {98, 65, 199},
+	"bluish":                {41, 118, 187},
+	"bluishgreen":           {16, 166, 116},
+	"bluishgrey":            {116, 139, 151},
+	"bluishpurple":          {112, 59, 231},
+	"blurple":               {85, 57, 204},
+	"blush":                 {242, 158, 142},
+	"blushpink":             {254, 130, 140},
+	"booger":                {155, 181, 60},
+	"boogergreen":           {150, 180, 3},
+	"bordeaux":              {123, 0, 44},
+	"boringgreen":           {99, 179, 101},
+	"bottlegreen":           {4, 74, 5},
+	"brick":                 {160, 54, 35},
+	"brickorange":           {193, 74, 9},
+	"brickred":              {143, 20, 2},
+	"brightaqua":            {11, 249, 234},
+	"brightblue":            {1, 101, 252},
+	"brightcyan":            {65, 253, 254},
+	"brightgreen":           {1, 255, 7},
+	"brightlavender":        {199, 96, 255},
+	"brightlightblue":       {38, 247, 253},
+	"brightlightgreen":      {45, 254, 84},
+	"brightlilac":           {201, 94, 251},
+	"brightlime":            {135, 253, 5},
+	"brightlimegreen":       {101, 254, 8},
+	"brightmagenta":         {255, 8, 232},
+	"brightolive":           {156, 187, 4},
+	"brightorange":          {255, 91, 0},
+	"brightpink":            {254, 1, 177},
+	"brightpurple":          {190, 3, 253},
+	"brightred":             {255, 0, 13},
+	"brightseagreen":        {5, 255, 166},
+	"brightskyblue":         {2, 204, 254},
+	"brightteal":            {1, 249, 198},
+	"brightturquoise":       {15, 254, 249},
+	"brightviolet":          {173, 10, 253},
+	"brightyellow":          {255, 253, 1},
+	"brightyellowgreen":     {157, 255, 0},
+	"britishracinggreen":    {5, 72, 13},
+	"bronze":                {168, 121, 0},
+	"brown":                 {101, 55, 0},
+	"browngreen":            {112, 108, 17},
+	"browngrey":             {141, 132, 104},
+	"brownish":              {156, 109, 87},
+	"brownishgreen":         {106, 110, 9},
+	"brownishgrey":          {134, 119, 95},
+	"brownishorange":        {203, 119, 35},
+	"brownishpink":          {194, 126, 121},
+	"brownishpurple":        {118, 66, 78},
+	"brownishred":           {158, 54, 35},
+	"brownishyellow":        {201, 176, 3},
+	"brownorange":           {185, 105, 2},
+	"brownred":              {146, 43, 5},
+	"brownyellow":           {178, 151, 5},
+	"brownygreen":           {111, 108, 10},
+	"brownyorange":          {202, 107, 2},
+	"bruise":                {126, 64, 113},
+	"bubblegum":             {255, 108, 181},
+	"bubblegumpink":         {254, 131, 204},
+	"buff":                  {254, 246, 158},
+	"burgundy":              {97, 0, 35},
+	"burntorange":           {192, 78, 1},
+	"burntred":              {159, 35, 5},
+	"burntsiena":            {183, 82, 3},
+	"burntsienna":           {176, 78, 15},
+	"burntumber":            {160, 69, 14},
+	"burntyellow":           {213, 171, 9},
+	"burple":                {104, 50, 227},
+	"butter":                {255, 255, 129},
+	"butterscotch":          {253, 177, 71},
+	"butteryellow":          {255, 253, 116},
+	"cadetblue":             {78, 116, 150},
+	"camel":                 {198, 159, 89},
+	"camo":                  {127, 143, 78},
+	"camogreen":             {82, 101, 37},
+	"camouflagegreen":       {75, 97, 19},
+	"canary":                {253, 255, 99},
+	"canaryyellow":          {255, 254, 64},
+	"candypink":             {255, 99, 233},
+	"caramel":               {175, 111, 9},
+	"carmine":               {157, 2, 22},
+	"carnation":             {253, 121, 143},
+	"carnationpink":         {255, 127, 167},
+	"carolinablue":          {138, 184, 254},
+	"celadon":               {190, 253, 183},
+	"celery":                {193, 253, 149},
+	"cement":                {165, 163, 145},
+	"cerise":                {222, 12, 98},
+	"cerulean":              {4, 133, 209},
+	"ceruleanblue":          {5, 110, 238},
+	"charcoal":              {52, 56, 55},
+	"charcoalgrey":          {60, 65, 66},
+	"chartreuse":            {193, 248, 10},
+	"cherry":                {207, 2, 52},
+	"cherryred":             {247, 2, 42},
+	"chestnut":              {116, 40, 2},
+	"chocolate":             {61, 28, 2},
+	"chocolatebrown":        {65, 25, 0},
+	"cinnamon":              {172, 79, 6},
+	"claret":                {104, 0, 24},
+	"clay":                  {182, 106, 80},
+	"claybrown":             {178, 113, 61},
+	"clearblue":             {36, 122, 253},
+	"cloudyblue":            {172, 194, 217},
+	"cobalt":                {30, 72, 143},
+	"cobaltblue":            {3, 10, 167},
+	"cocoa":                 {135, 95, 66},
+	"coffee":                {166, 129, 76},
+	"coolblue":              {73, 132, 184},
+	"coolgreen":             {51, 184, 100},
+	"coolgrey":              {149, 163, 166},
+	"copper":                {182, 99, 37},
+	"coral":                 {252, 90, 80},
+	"coralpink":             {255, 97, 99},
+	"cornflower":            {106, 121, 247},
+	"cornflowerblue":        {81, 112, 215},
+	"cranberry":             {158, 0, 58},
+	"cream":                 {255, 255, 194},
+	"creme":                 {255, 255, 182},
+	"crimson":               {140, 0, 15},
+	"custard":               {255, 253, 120},
+	"cyan":                  {0, 255, 255},
+	"dandelion":             {254, 223, 8},
+	"dark":                  {27, 36, 49},
+	"darkaqua":              {5, 105, 107},
+	"darkaquamarine":        {1, 115, 113},
+	"darkbeige":             {172, 147, 98},
+	"darkblue":              {0, 3, 91},
+	"darkbluegreen":         {0, 82, 73},
+	"darkbluegrey":          {31, 59, 77},
+	"darkbrown":             {52, 28, 2},
+	"darkcoral":             {207, 82, 78},
+	"darkcream":             {255, 243, 154},
+	"darkcyan":              {10, 136, 138},
+	"darkforestgreen":       {0, 45, 4},
+	"darkfuchsia":           {157, 7, 89},
+	"darkgold":              {181, 148, 16},
+	"darkgrassgreen":        {56, 128, 4},
+	"darkgreen":             {3, 53, 0},
+	"darkgreenblue":         {31, 99, 87},
+	"darkgrey":              {54, 55, 55},
+	"darkgreyblue":          {41, 70, 91},
+	"darkhotpink":           {217, 1, 102},
+	"darkindigo":            {31, 9, 84},
+	"darkishblue":           {1, 65, 130},
+	"darkishgreen":          {40, 124, 55},
+	"darkishpink":           {218, 70, 125},
+	"darkishpurple":         {117, 25, 115},
+	"darkishred":            {169, 3, 8},
+	"darkkhaki":             {155, 143, 85},
+	"darklavender":          {133, 103, 152},
+	"darklilac":             {156, 109, 165},
+	"darklime":              {132, 183, 1},
+	"darklimegreen":         {126, 189, 1},
+	"darkmagenta":           {150, 0, 86},
+	"darkmaroon":            {60, 0, 8},
+	"darkmauve":             {135, 76, 98},
+	"darkmint":              {72, 192, 114},
+	"darkmintgreen":         {32, 192, 115},
+	"darkmustard":           {168, 137, 5},
+	"darknavy":              {0, 4, 53},
+	"darknavyblue":          {0, 2, 46},
+	"darkolive":             {55, 62, 2},
+	"darkolivegreen":        {60, 77, 3},
+	"darkorange":            {198, 81, 2},
+	"darkpastelgreen":       {86, 174, 87},
+	"darkpeach":             {222, 126, 93},
+	"darkperiwinkle":        {102, 95, 209},
+	"darkpink":              {203, 65, 107},
+	"darkplum":              {63, 1, 44},
+	"darkpurple":            {53, 6, 62},
+	"darkred":               {132, 0, 0},
+	"darkrose":              {181, 72, 93},
+	"darkroyalblue":         {2, 6, 111},
+	"darksage":              {89, 133, 86},
+	"darksalmon":            {200, 90, 83},
+	"darksand":              {168, 143, 89},
+	"darkseafoam":           {31, 181, 122},
+	"darkseafoamgreen":      {62, 175, 118},
+	"darkseagreen":          {17, 135, 93},
+	"darkskyblue":           {68, 142, 228},
+	"darkslateblue":         {33, 71, 97},
+	"darktan":               {175, 136, 74},
+	"darktaupe":             {127, 104, 78},
+	"darkteal":              {1, 77, 78},
+	"darkturquoise":         {4, 92, 90},
+	"darkviolet":            {52, 1, 63},
+	"darkyellow":            {213, 182, 10},
+	"darkyellowgreen":       {114, 143, 2},
+	"deepaqua":              {8, 120, 127},
+	"deepblue":              {4, 2, 115},
+	"deepbrown":             {65, 2, 0},
+	"deepgreen":             {2, 89, 15},
+	"deeplavender":          {141, 94, 183},
+	"deeplilac":             {150, 110, 189},
+	"deepmagenta":           {160, 2, 92},
+	"deeporange":            {220, 77, 1},
+	"deeppink":              {203, 1, 98},
+	"deeppurple":            {54, 1, 63},
+	"deepred":               {154, 2, 0},
+	"deeprose":              {199, 71, 103},
+	"deepseablue":           {1, 84, 130},
+	"deepskyblue":           {13, 117, 248},
+	"deepteal":              {0, 85, 90},
+	"deepturquoise":         {1, 115, 116},
+	"deepviolet":            {73, 6, 72},
+	"denim":                 {59, 99, 140},
+	"denimblue":             {59, 91, 146},
+	"desert":                {204, 173, 96},
+	"diarrhea":              {159, 131, 3},
+	"dirt":                  {138, 110, 69},
+	"dirtbrown":             {131, 101, 57},
+	"dirtyblue":             {63, 130, 157},
+	"dirtygreen":            {102, 126, 44},
+	"dirtyorange":           {200, 118, 6},
+	"dirtypink":             {202, 123, 128},
+	"dirtypurple":           {115, 74, 101},
+	"dirtyyellow":           {205, 197, 10},
+	"dodgerblue":            {62, 130, 252},
+	"drab":                  {130, 131, 68},
+	"drabgreen":             {116, 149, 81},
+	"driedblood":            {75, 1, 1},
+	"duckeggblue":           {195, 251, 244},
+	"dullblue":              {73, 117, 156},
+	"dullbrown":             {135, 110, 75},
+	"dullgreen":             {116, 166, 98},
+	"dullorange":            {216, 134, 59},
+	"dullpink":              {213, 134, 157},
+	"dullpurple":            {132, 89, 126},
+	"dullred":               {187, 63, 63},
+	"dullteal":              {95, 158, 143},
+	"dullyellow":            {238, 220, 91},
+	"dusk":                  {78, 84, 129},
+	"duskblue":              {38, 83, 141},
+	"duskyblue":             {71, 95, 148},
+	"duskypink":             {204, 122, 139},
+	"duskypurple":           {137, 91, 123},
+	"duskyrose":             {186, 104, 115},
+	"dust":                  {178, 153, 110},
+	"dustyblue":             {90, 134, 173},
+	"dustygreen":            {118, 169, 115},
+	"dustylavender":         {172, 134, 168},
+	"dustyorange":           {240, 131, 58},
+	"dustypink":             {213, 138, 148},
+	"dustypurple":           {130, 95, 135},
+	"dustyred":              {185, 72, 78},
+	"dustyrose":             {192, 115, 122},
+	"dustyteal":             {76, 144, 133},
+	"earth":                 {162, 101, 62},
+	"eastergreen":           {140, 253, 126},
+	"easterpurple":          {192, 113, 254},
+	"ecru":                  {254, 255, 202},
+	"eggplant":              {56, 8, 53},
+	"eggplantpurple":        {67, 5, 65},
+	"eggshell":              {255, 255, 212},
+	"eggshellblue":          {196, 255, 247},
+	"electricblue":          {6, 82, 255},
+	"electricgreen":         {33, 252, 13},
+	"electriclime":          {168, 255, 4},
+	"electricpink":          {255, 4, 144},
+	"electricpurple":        {170, 35, 255},
+	"emerald":               {1, 160, 73},
+	"emeraldgreen":          {2, 143, 30},
+	"evergreen":             {5, 71, 42},
+	"fadedblue":             {101, 140, 187},
+	"fadedgreen":            {123, 178, 116},
+	"fadedorange":           {240, 148, 77},
+	"fadedpink":             {222, 157, 172},
+	"fadedpurple":           {145, 110, 153},
+	"fadedred":              {211, 73, 78},
+	"fadedyellow":           {254, 255, 127},
+	"fawn":                  {207, 175, 123},
+	"fern":                  {99, 169, 80},
+	"ferngreen":             {84, 141, 68},
+	"fireenginered":         {254, 0, 2},
+	"flatblue":              {60, 115, 168},
+	"flatgreen":             {105, 157, 76},
+	"fluorescentgreen":      {8, 255, 8},
+	"flurogreen":            {10, 255, 2},
+	"foamgreen":             {144, 253, 169},
+	"forest":                {11, 85, 9},
+	"forestgreen":           {6, 71, 12},
+	"forrestgreen":          {21, 68, 6},
+	"frenchblue":            {67, 107, 173},
+	"freshgreen":            {105, 216, 79},
+	"froggreen":             {88, 188, 8},
+	"fuchsia":               {237, 13, 217},
+	"gold":                  {219, 180, 12},
+	"golden":                {245, 191, 3},
+	"goldenbrown":           {178, 122, 1},
+	"goldenrod":             {250, 194, 5},
+	"goldenyellow":          {254, 198, 21},
+	"grape":                 {108, 52, 97},
+	"grapefruit":            {253, 89, 86},
+	"grapepurple":           {93, 20, 81},
+	"grass":                 {92, 172, 45},
+	"grassgreen":            {63, 155, 11},
+	"grassygreen":           {65, 156, 3},
+	"green":                 {21, 176, 26},
+	"green-blue":            {1, 192, 141},
+	"green-yellow":          {181, 206, 8},
+	"greenapple":            {94, 220, 31},
+	"greenblue":             {6, 180, 139},
+	"greenbrown":            {84, 78, 3},
+	"greengrey":             {119, 146, 111},
+	"greenish":              {64, 163, 104},
+	"greenishbeige":         {201, 209, 121},
+	"greenishblue":          {11, 139, 135},
+	"greenishbrown":         {105, 97, 18},
+	"greenishcyan":          {42, 254, 183},
+	"greenishgrey":          {150, 174, 141},
+	"greenishtan":           {188, 203, 122},
+	"greenishteal":          {50, 191, 132},
+	"greenishturquoise":     {0, 251, 176},
+	"greenishyellow":        {205, 253, 2},
+	"greenteal":             {12, 181, 119},
+	"greenyblue":            {66, 179, 149},
+	"greenybrown":           {105, 96, 6},
+	"greenyellow":           {201, 255, 39},
+	"greenygrey":            {126, 160, 122},
+	"greenyyellow":          {198, 248, 8},
+	"grey":                  {146, 149, 145},
+	"grey-blue":             {100, 125, 142},
+	"grey-green":            {134, 161, 125},
+	"greyblue":              {107, 139, 164},
+	"greybrown":             {127, 112, 83},
+	"greygreen":             {120, 155, 115},
+	"greyish":               {168, 164, 149},
+	"greyishblue":           {94, 129, 157},
+	"greyishbrown":          {122, 106, 79},
+	"greyishgreen":          {130, 166, 125},
+	"greyishpink":           {200, 141, 148},
+	"greyishpurple":         {136, 113, 145},
+	"greyishteal":           {113, 159, 145},
+	"greypink":              {195, 144, 155},
+	"greypurple":            {130, 109, 140},
+	"greyteal":              {94, 155, 138},
+	"grossgreen":            {160, 191, 22},
+	"gunmetal":              {83, 98, 103},
+	"hazel":                 {142, 118, 24},
+	"heather":               {164, 132, 172},
+	"heliotrope":            {217, 79, 245},
+	"highlightergreen":      {27, 252, 6},
+	"hospitalgreen":         {155, 229, 170},
+	"hotgreen":              {37, 255, 41},
+	"hotmagenta":            {245, 4, 201},
+	"hotpink":               {255, 2, 141},
+	"hotpurple":             {203, 0, 245},
+	"huntergreen":           {11, 64, 8},
+	"ice":                   {214, 255, 250},
+	"iceblue":               {215, 255, 254},
+	"ickygreen":             {143, 174, 34},
+	"indianred":             {133, 14, 4},
+	"indigo":                {56, 2, 130},
+	"indigoblue":            {58, 24, 177},
+	"iris":                  {98, 88, 196},
+	"irishgreen":            {1, 149, 41},
+	"ivory":                 {255, 255, 203},
+	"jade":                  {31, 167, 116},
+	"jadegreen":             {43, 175, 106},
+	"junglegreen":           {4, 130, 67},
+	"kelleygreen":           {0, 147, 55},
+	"kellygreen":            {2, 171, 46},
+	"kermitgreen":           {92, 178, 0},
+	"keylime":               {174, 255, 110},
+	"khaki":                 {170, 166, 98},
+	"khakigreen":            {114, 134, 57},
+	"kiwi":                  {156, 239, 67},
+	"kiwigreen":             {142, 229, 63},
+	"lavender":              {199, 159, 239},
+	"lavenderblue":          {139, 136, 248},
+	"lavenderpink":          {221, 133, 215},
+	"lawngreen":             {77, 164, 9},
+	"leaf":                  {113, 170, 52},
+	"leafgreen":             {92, 169, 4},
+	"leafygreen":            {81, 183, 59},
+	"leather":               {172, 116, 52},
+	"lemon":                 {253, 255, 82},
+	"lemongreen":            {173, 248, 2},
+	"lemonlime":             {191, 254, 40},
+	"lemonyellow":           {253, 255, 56},
+	"lichen":                {143, 182, 123},
+	"lightaqua":             {140, 255, 219},
+	"lightaquamarine":       {123, 253, 199},
+	"lightbeige":            {255, 254, 182},
+	"lightblue":             {149, 208, 252},
+	"lightbluegreen":        {126, 251, 179},
+	"lightbluegrey":         {183, 201, 226},
+	"lightbluishgreen":      {118, 253, 168},
+	"lightbrightgreen":      {83, 254, 92},
+	"lightbrown":            {173, 129, 80},
+	"lightburgundy":         {168, 65, 91},
+	"lightcyan":             {172, 255, 252},
+	"lighteggplant":         {137, 69, 133},
+	"lightergreen":          {117, 253, 99},
+	"lighterpurple":         {165, 90, 244},
+	"lightforestgreen":      {79, 145, 83},
+	"lightgold":             {253, 220, 92},
+	"lightgrassgreen":       {154, 247, 100},
+	"lightgreen":            {150, 249, 123},
+	"lightgreenblue":        {86, 252, 162},
+	"lightgreenishblue":     {99, 247, 180},
+	"lightgrey":             {216, 220, 214},
+	"lightgreyblue":         {157, 188, 212},
+	"lightgreygreen":        {183, 225, 161},
+	"lightindigo":           {109, 90, 207},
+	"lightishblue":          {61, 122, 253},
+	"lightishgreen":         {97, 225, 96},
+	"lightishpurple":        {165, 82, 230},
+	"lightishred":           {254, 47, 74},
+	"lightkhaki":            {230, 242, 162},
+	"lightlavendar":         {239, 192, 254},
+	"lightlavender":         {223, 197, 254},
+	"lightlightblue":        {202, 255, 251},
+	"lightlightgreen":       {200, 255, 176},
+	"lightlilac":            {237, 200, 255},
+	"lightlime":             {174, 253, 108},
+	"lightlimegreen":        {185, 255, 102},
+	"lightmagenta":          {250, 95, 247},
+	"lightmaroon":           {162, 72, 87},
+	"lightmauve":            {194, 146, 161},
+	"lightmint":             {182, 255, 187},
+	"lightmintgreen":        {166, 251, 178},
+	"lightmossgreen":        {166, 200, 117},
+	"lightmustard":          {247, 213, 96},
+	"lightnavy":             {21, 80, 132},
+	"lightnavyblue":         {46, 90, 136},
+	"lightneongreen":        {78, 253, 84},
+	"lightolive":            {172, 191, 105},
+	"lightolivegreen":       {164, 190, 92},
+	"lightorange":           {253, 170, 72},
+	"lightpastelgreen":      {178, 251, 165},
+	"lightpeach":            {255, 216, 177},
+	"lightpeagreen":         {196, 254, 130},
+	"lightperiwinkle":       {193, 198, 252},
+	"lightpink":             {255, 209, 223},
+	"lightplum":             {157, 87, 131},
+	"lightpurple":           {191, 119, 246},
+	"lightred":              {255, 71, 76},
+	"lightrose":             {255, 197, 203},
+	"lightroyalblue":        {58, 46, 254},
+	"lightsage":             {188, 236, 172},
+	"lightsalmon":           {254, 169, 147},
+	"lightseafoam":          {160, 254, 191},
+	"lightseafoamgreen":     {167, 255, 181},
+	"lightseagreen":         {152, 246, 176},
+	"lightskyblue":          {198, 252, 255},
+	"lighttan":              {251, 238, 172},
+	"lightteal":             {144, 228, 193},
+	"lightturquoise":        {126, 244, 204},
+	"lighturple":            {179, 111, 246},
+	"lightviolet":           {214, 180, 252},
+	"lightyellow":           {255, 254, 122},
+	"lightyellowgreen":      {204, 253, 127},
+	"lightyellowishgreen":   {194, 255, 137},
+	"lilac":                 {206, 162, 253},
+	"liliac":                {196, 142, 253},
+	"lime":                  {170, 255, 50},
+	"limegreen":             {137, 254, 5},
+	"limeyellow":            {208, 254, 29},
+	"lipstick":              {213, 23, 78},
+	"lipstickred":           {192, 2, 47},
+	"macaroniandcheese":     {239, 180, 53},
+	"magenta":               {194, 0, 120},
+	"mahogany":              {74, 1, 0},
+	"maize":                 {244, 208, 84},
+	"mango":                 {255, 166, 43},
+	"manilla":               {255, 250, 134},
+	"marigold":              {252, 192, 6},
+	"marine":                {4, 46, 96},
+	"marineblue":            {1, 56, 106},
+	"maroon":                {101, 0, 33},
+	"mauve":                 {174, 113, 129},
+	"mediumblue":            {44, 111, 187},
+	"mediumbrown":           {127, 81, 18},
+	"mediumgreen":           {57, 173, 72},
+	"mediumgrey":            {125, 127, 124},
+	"mediumpink":            {243, 97, 150},
+	"mediumpurple":          {158, 67, 162},
+	"melon":                 {255, 120, 85},
+	"merlot":                {115, 0, 57},
+	"metallicblue":          {79, 115, 142},
+	"midblue":               {39, 106, 179},
+	"midgreen":              {80, 167, 71},
+	"midnight":              {3, 1, 45},
+	"midnightblue":          {2, 0, 53},
+	"midnightpurple":        {40, 1, 55},
+	"militarygreen":         {102, 124, 62},
+	"milkchocolate":         {127, 78, 30},
+	"mint":                  {159, 254, 176},
+	"mintgreen":             {143, 255, 159},
+	"mintygreen":            {11, 247, 125},
+	"mocha":                 {157, 118, 81},
+	"moss":                  {118, 153, 88},
+	"mossgreen":             {101, 139, 56},
+	"mossygreen":            {99, 139, 39},
+	"mud":                   {115, 92, 18},
+	"mudbrown":              {96, 70, 15},
+	"muddybrown":            {136, 104, 6},
+	"muddygreen":            {101, 116, 50},
+	"muddyyellow":           {191, 172, 5},
+	"mudgreen":              {96, 102, 2},
+	"mulberry":              {146, 10, 78},
+	"murkygreen":            {108, 122, 14},
+	"mushroom":              {186, 158, 136},
+	"mustard":               {206, 179, 1},
+	"mustardbrown":          {172, 126, 4},
+	"mustardgreen":          {168, 181, 4},
+	"mustardyellow":         {210, 189, 10},
+	"mutedblue":             {59, 113, 159},
+	"mutedgreen":            {95, 160, 82},
+	"mutedpink":             {209, 118, 143},
+	"mutedpurple":           {128, 91, 135},
+	"nastygreen":            {112, 178, 63},
+	"navy":                  {1, 21, 62},
+	"navyblue":              {0, 17, 70},
+	"navygreen":             {53, 83, 10},
+	"neonblue":              {4, 217, 255},
+	"neongreen":             {12, 255, 12},
+	"neonpink":              {254, 1, 154},
+	"neonpurple":            {188, 19, 254},
+	"neonred":               {255, 7, 58},
+	"neonyellow":            {207, 255, 4},
+	"niceblue":              {16, 122, 176},
+	"nightblue":             {4, 3, 72},
+	"ocean":                 {1, 123, 146},
+	"oceanblue":             {3, 113, 156},
+	"oceangreen":            {61, 153, 115},
+	"ocher":                 {191, 155, 12},
+	"ochre":                 {191, 144, 5},
+	"ocre":                  {198, 156, 4},
+	"offblue":               {86, 132, 174},
+	"offgreen":              {107, 163, 83},
+	"offwhite":              {255, 255, 228},
+	"offyellow":             {241, 243, 63},
+	"oldpink":               {199, 121, 134},
+	"oldrose":               {200, 127, 137},
+	"olive":                 {110, 117, 14},
+	"olivebrown":            {100, 84, 3},
+	"olivedrab":             {111, 118, 50},
+	"olivegreen":            {103, 122, 4},
+	"oliveyellow":           {194, 183, 9},
+	"orange":                {249, 115, 6},
+	"orangebrown":           {190, 100, 0},
+	"orangeish":             {253, 141, 73},
+	"orangepink":            {255, 111, 82},
+	"orangered":             {253, 65, 30},
+	"orangeybrown":          {177, 96, 2},
+	"orangeyellow":          {255, 173, 1},
+	"orangeyred":            {250, 66, 36},
+	"orangeyyellow":         {253, 185, 21},
+	"orangish":              {252, 130, 74},
+	"orangishbrown":         {178, 95, 3},
+	"orangishred":           {244, 54, 5},
+	"orchid":                {200, 117, 196},
+	"pale":                  {255, 249, 208},
+	"paleaqua":              {184, 255, 235},
+	"paleblue":              {208, 254, 254},
+	"palebrown":             {177, 145, 110},
+	"palecyan":              {183, 255, 250},
+	"palegold":              {253, 222, 108},
+	"palegreen":             {199, 253, 181},
+	"palegrey":              {253, 253, 254},
+	"palelavender":          {238, 207, 254},
+	"palelightgreen":        {177, 252, 153},
+	"palelilac":             {228, 203, 255},
+	"palelime":              {190, 253, 115},
+	"palelimegreen":         {177, 255, 101},
+	"palemagenta":           {215, 103, 173},
+	"palemauve":             {254, 208, 252},
+	"paleolive":             {185, 204, 129},
+	"paleolivegreen":        {177, 210, 123},
+	"paleorange":            {255, 167, 86},
+	"palepeach":             {255, 229, 173},
+	"palepink":              {255, 207, 220},
+	"palepurple":            {183, 144, 212},
+	"palered":               {217, 84, 77},
+	"palerose":              {253, 193, 197},
+	"palesalmon":            {255, 177, 154},
+	"paleskyblue":           {189, 246, 254},
+	"paleteal":              {130, 203, 178},
+	"paleturquoise":         {165, 251, 213},
+	"paleviolet":            {206, 174, 250},
+	"paleyellow":            {255, 255, 132},
+	"parchment":             {254, 252, 175},
+	"pastelblue":            {162, 191, 254},
+	"pastelgreen":           {176, 255, 157},
+	"pastelorange":          {255, 150, 79},
+	"pastelpink":            {255, 186, 205},
+	"pastelpurple":          {202, 160, 255},
+	"pastelred":             {219, 88, 86},
+	"pastelyellow":          {255, 254, 113},
+	"pea":                   {164, 191, 32},
+	"peach":                 {255, 176, 124},
+	"peachypink":            {255, 154, 138},
+	"peacockblue":           {1, 103, 149},
+	"peagreen":              {142, 171, 18},
+	"pear":                  {203, 248, 95},
+	"peasoup":               {146, 153, 1},
+	"peasoupgreen":          {148, 166, 23},
+	"periwinkle":            {142, 130, 254},
+	"periwinkleblue":        {143, 153, 251},
+	"perrywinkle":           {143, 140, 231},
+	"petrol":                {0, 95, 106},
+	"pigpink":               {231, 142, 165},
+	"pine":                  {43, 93, 52},
+	"pinegreen":             {10, 72, 30},
+	"pink":                  {255, 129, 192},
+	"pink-purple":           {239, 29, 231},
+	"pinkish":               {212, 106, 126},
+	"pinkishbrown":          {177, 114, 97},
+	"pinkishgrey":           {200, 172, 169},
+	"pinkishorange":         {255, 114, 76},
+	"pinkishpurple":         {214, 72, 215},
+	"pinkishred":            {241, 12, 69},
+	"pinkishtan":            {217, 155, 130},
+	"pinkpurple":            {219, 75, 218},
+	"pinkred":               {245, 5, 79},
+	"pinky":                 {252, 134, 170},
+	"pinkypurple":           {201, 76, 190},
+	"pinkyred":              {252, 38, 71},
+	"pissyellow":            {221, 214, 24},
+	"pistachio":             {192, 250, 139},
+	"plum":                  {88, 15, 65},
+	"plumpurple":            {78, 5, 80},
+	"poisongreen":           {64, 253, 20},
+	"poo":                   {143, 115, 3},
+	"poobrown":              {136, 95, 1},
+	"poop":                  {127, 94, 0},
+	"poopbrown":             {122, 89, 1},
+	"poopgreen":             {111, 124, 0},
+	"powderblue":            {177, 209, 252},
+	"powderpink":            {255, 178, 208},
+	"primaryblue":           {8, 4, 249},
+	"prussianblue":          {0, 69, 119},
+	"puce":                  {165, 126, 82},
+	"puke":                  {165, 165, 2},
+	"pukebrown":             {148, 119, 6},
+	"pukegreen":             {154, 174, 7},
+	"pukeyellow":            {194, 190, 14},
+	"pumpkin":               {225, 119, 1},
+	"pumpkinorange":         {251, 125, 7},
+	"pureblue":              {2, 3, 226},
+	"purple":                {126, 30, 156},
+	"purple-blue":           {93, 33, 208},
+	"purple-pink":           {215, 37, 222},
+	"purpleblue":            {99, 45, 233},
+	"purplebrown":           {103, 58, 63},
+	"purplegrey":            {134, 111, 133},
+	"purpleish":             {152, 86, 141},
+	"purpleishblue":         {97, 64, 239},
+	"purpleishpink":         {223, 78, 200},
+	"purplepink":            {224, 63, 216},
+	"purplered":             {153, 1, 71},
+	"purpley":               {135, 86, 228},
+	"purpleyblue":           {95, 52, 231},
+	"purpleygrey":           {148, 126, 148},
+	"purpleypink":           {200, 60, 185},
+	"purplish":              {148, 86, 140},
+	"purplishblue":          {96, 30, 249},
+	"purplishbrown":         {107, 66, 71},
+	"purplishgrey":          {122, 104, 127},
+	"purplishpink":          {206, 93, 174},
+	"purplishred":           {176, 5, 75},
+	"purply":                {152, 63, 178},
+	"purplyblue":            {102, 26, 238},
+	"purplypink":            {240, 117, 230},
+	"putty":                 {190, 174, 138},
+	"racinggreen":           {1, 70, 0},
+	"radioactivegreen":      {44, 250, 31},
+	"raspberry":             {176, 1, 73},
+	"rawsienna":             {154, 98, 0},
+	"rawumber":              {167, 94, 9},
+	"reallylightblue":       {212, 255, 255},
+	"red":                   {229, 0, 0},
+	"redbrown":              {139, 46, 22},
+	"reddish":               {196, 66, 64},
+	"reddishbrown":          {127, 43, 10},
+	"reddishgrey":           {153, 117, 112},
+	"reddishorange":         {248, 72, 28},
+	"reddishpink":           {254, 44, 84},
+	"reddishpurple":         {145, 9, 81},
+	"reddybrown":            {110, 16, 5},
+	"redorange":             {253, 60, 6},
+	"redpink":               {250, 42, 85},
+	"redpurple":             {130, 7, 71},
+	"redviolet":             {158, 1, 104},
+	"redwine":               {140, 0, 52},
+	"richblue":              {2, 27, 249},
+	"richpurple":            {114, 0, 88},
+	"robineggblue":          {138, 241, 254},
+	"robinsegg":             {109, 237, 253},
+	"robinseggblue":         {152, 239, 249},
+	"rosa":                  {254, 134, 164},
+	"rose":                  {207, 98, 117},
+	"rosepink":              {247, 135, 154},
+	"rosered":               {190, 1, 60},
+	"rosypink":              {246, 104, 142},
+	"rouge":                 {171, 18, 57},
+	"royal":                 {12, 23, 147},
+	"royalblue":             {5, 4, 170},
+	"royalpurple":           {75, 0, 110},
+	"ruby":                  {202, 1, 71},
+	"russet":                {161, 57, 5},
+	"rust":                  {168, 60, 9},
+	"rustbrown":             {139, 49, 3},
+	"rustorange":            {196, 85, 8},
+	"rustred":               {170, 39, 4},
+	"rustyorange":           {205, 89, 9},
+	"rustyred":              {175, 47, 13},
+	"saffron":               {254, 178, 9},
+	"sage":                  {135, 174, 115},
+	"sagegreen":             {136, 179, 120},
+	"salmon":                {255, 121, 108},
+	"salmonpink":            {254, 123, 124},
+	"sand":                  {226, 202, 118},
+	"sandbrown":             {203, 165, 96},
+	"sandstone":             {201, 174, 116},
+	"sandy":                 {241, 218, 122},
+	"sandybrown":            {196, 166, 97},
+	"sandyellow":            {252, 225, 102},
+	"sandyyellow":           {253, 238, 115},
+	"sapgreen":              {92, 139, 21},
+	"sapphire":              {33, 56, 171},
+	"scarlet":               {190, 1, 25},
+	"sea":                   {60, 153, 146},
+	"seablue":               {4, 116, 149},
+	"seafoam":               {128, 249, 173},
+	"seafoamblue":           {120, 209, 182},
+	"seafoamgreen":          {122, 249, 171},
+	"seagreen":              {83, 252, 161},
+	"seaweed":               {24, 209, 123},
+	"seaweedgreen":          {53, 173, 107},
+	"sepia":                 {152, 94, 43},
+	"shamrock":              {1, 180, 76},
+	"shamrockgreen":         {2, 193, 77},
+	"shit":                  {127, 95, 0},
+	"shitbrown":             {123, 88, 4},
+	"shitgreen":             {117, 128, 0},
+	"shockingpink":          {254, 2, 162},
+	"sickgreen":             {157, 185, 44},
+	"sicklygreen":           {148, 178, 28},
+	"sicklyyellow":          {208, 228, 41},
+	"sienna":                {169, 86, 30},
+	"silver":                {197, 201, 199},
+	"sky":                   {130, 202, 252},
+	"skyblue":               {117, 187, 253},
+	"slate":                 {81, 101, 114},
+	"slateblue":             {91, 124, 153},
+	"slategreen":            {101, 141, 109},
+	"slategrey":             {89, 101, 109},
+	"slimegreen":            {153, 204, 4},
+	"snot":                  {172, 187, 13},
+	"snotgreen":             {157, 193, 0},
+	"softblue":              {100, 136, 234},
+	"softgreen":             {111, 194, 118},
+	"softpink":              {253, 176, 192},
+	"softpurple":            {166, 111, 181},
+	"spearmint":             {30, 248, 118},
+	"springgreen":           {169, 249, 113},
+	"spruce":                {10, 95, 56},
+	"squash":                {242, 171, 21},
+	"steel":                 {115, 133, 149},
+	"steelblue":             {90, 125, 154},
+	"steelgrey":             {111, 130, 138},
+	"stone":                 {173, 165, 135},
+	"stormyblue":            {80, 123, 156},
+	"straw":                 {252, 246, 121},
+	"strawberry":            {251, 41, 67},
+	"strongblue":            {12, 6, 247},
+	"strongpink":            {255, 7, 137},
+	"sunflower":             {255, 197, 18},
+	"sunfloweryellow":       {255, 218, 3},
+	"sunnyyellow":           {255, 249, 23},
+	"sunshineyellow":        {255, 253, 55},
+	"sunyellow":             {255, 223, 34},
+	"swamp":                 {105, 131, 57},
+	"swampgreen":            {116, 133, 0},
+	"tan":                   {209, 178, 111},
+	"tanbrown":              {171, 126, 76},
+	"tangerine":             {255, 148, 8},
+	"tangreen":              {169, 190, 112},
+	"taupe":                 {185, 162, 129},
+	"tea":                   {101, 171, 124},
+	"teagreen":              {189, 248, 163},
+	"teal":                  {2, 147, 134},
+	"tealblue":              {1, 136, 159},
+	"tealgreen":             {37, 163, 111},
+	"tealish":               {36, 188, 168},
+	"tealishgreen":          {12, 220, 115},
+	"terracota":             {203, 104, 67},
+	"terracotta":            {202, 102, 65},
+	"tiffanyblue":           {123, 242, 218},
+	"tomato":                {239, 64, 38},
+	"tomatored":             {236, 45, 1},
+	"topaz":                 {19, 187, 175},
+	"toupe":                 {199, 172, 125},
+	"toxicgreen":            {97, 222, 42},
+	"treegreen":             {42, 126, 25},
+	"trueblue":              {1, 15, 204},
+	"truegreen":             {8, 148, 4},
+	"turquoise":             {6, 194, 172},
+	"turquoiseblue":         {6, 177, 196},
+	"turquoisegreen":        {4, 244, 137},
+	"turtlegreen":           {117, 184, 79},
+	"twilight":              {78, 81, 139},
+	"twilightblue":          {10, 67, 122},
+	"uglyblue":              {49, 102, 138},
+	"uglybrown":             {125, 113, 3},
+	"uglygreen":             {122, 151, 3},
+	"uglypink":              {205, 117, 132},
+	"uglypurple":            {164, 66, 160},
+	"uglyyellow":            {208, 193, 1},
+	"ultramarine":           {32, 0, 177},
+	"ultramarineblue":       {24, 5, 219},
+	"umber":                 {178, 100, 0},
+	"velvet":                {117, 8, 81},
+	"vermillion":            {244, 50, 12},
+	"verydarkblue":          {0, 1, 51},
+	"verydarkbrown":         {29, 2, 0},
+	"verydarkgreen":         {6, 46, 3},
+	"verydarkpurple":        {42, 1, 52},
+	"verylightblue":         {213, 255, 255},
+	"verylightbrown":        {211, 182, 131},
+	"verylightgreen":        {209, 255, 189},
+	"verylightpink":         {255, 244, 242},
+	"verylightpurple":       {246, 206, 252},
+	"verypaleblue":          {214, 255, 254},
+	"verypalegreen":         {207, 253, 188},
+	"vibrantblue":           {3, 57, 248},
+	"vibrantgreen":          {10, 221, 8},
+	"vibrantpurple":         {173, 3, 222},
+	"violet":                {154, 14, 234},
+	"violetblue":            {81, 10, 201},
+	"violetpink":            {251, 95, 252},
+	"violetred":             {165, 0, 85},
+	"viridian":              {30, 145, 103},
+	"vividblue":             {21, 46, 255},
+	"vividgreen":            {47, 239, 16},
+	"vividpurple":           {153, 0, 250},
+	"vomit":                 {162, 164, 21},
+	"vomitgreen":            {137, 162, 3},
+	"vomityellow":           {199, 193, 12},
+	"warmblue":              {75, 87, 219},
+	"warmbrown":             {150, 78, 2},
+	"warmgrey":              {151, 138, 132},
+	"warmpink":              {251, 85, 129},
+	"warmpurple":            {149, 46, 143},
+	"washedoutgreen":        {188, 245, 166},
+	"waterblue":             {14, 135, 204},
+	"watermelon":            {253, 70, 89},
+	"weirdgreen":            {58, 229, 127},
+	"wheat":                 {251, 221, 126},
+	"white":                 {255, 255, 255},
+	"windowsblue":           {55, 120, 191},
+	"wine":                  {128, 1, 63},
+	"winered":               {123, 3, 35},
+	"wintergreen":           {32, 249, 134},
+	"wisteria":              {168, 125, 194},
+	"yellow":                {255, 255, 20},
+	"yellow-green":          {200, 253, 61},
+	"yellowbrown":           {183, 148, 0},
+	"yellowgreen":           {192, 251, 45},
+	"yellowish":             {250, 238, 102},
+	"yellowishbrown":        {155, 122, 1},
+	"yellowishgreen":        {176, 221, 22},
+	"yellowishorange":       {255, 171, 15},
+	"yellowishtan":          {252, 252, 129},
+	"yellowochre":           {203, 157, 6},
+	"yelloworange":          {252, 176, 1},
+	"yellowtan":             {255, 227, 110},
+	"yellowybrown":          {174, 139, 12},
+	"yellowygreen":          {191, 241, 40},
+}
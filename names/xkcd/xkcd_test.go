@@ -0,0 +1,91 @@
+package xkcd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mazznoer/csscolorparser"
+)
+
+func TestColors(t *testing.T) {
+	colors := Colors()
+	if len(colors) != 934 {
+		t.Fatalf("got %d colors", len(colors))
+	}
+	for name, hex := range map[string]string{
+		"cloudyblue":    "#acc2d9",
+		"robinseggblue": "#98eff9",
+		"blue-green":    "#0f9b8e",
+		"bluegreen":     "#137e6d",
+		"green":         "#15b01a",
+		"purple":        "#7e1e9c",
+	} {
+		if c := colors[name]; c.HexString() != hex {
+			t.Errorf("%s: expected %s, got %s", name, hex, c.HexString())
+		}
+	}
+	for name := range colors {
+		if Name(name) != name {
+			t.Errorf("%q is not a registry name", name)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	// The first lines of the survey results
+	const data = `License: http://creativecommons.org/publicdomain/zero/1.0/
+cloudy blue	#acc2d9	
+dark pastel green	#56ae57	
+dust	#b2996e	
+electric lime	#a8ff04	
+fresh green	#69d84f	
+light eggplant	#894585	
+nasty green	#70b23f	
+
+robin's egg blue	#98eff9	
+blue/green	#0f9b8e	
+`
+	colors, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(colors) != 9 {
+		t.Fatalf("got %d colors", len(colors))
+	}
+	for name, hex := range map[string]string{
+		"cloudyblue":    "#acc2d9",
+		"robinseggblue": "#98eff9",
+		"blue-green":    "#0f9b8e",
+	} {
+		if c := colors[name]; c.HexString() != hex {
+			t.Errorf("%s: expected %s, got %s", name, hex, c.HexString())
+		}
+	}
+
+	r, err := csscolorparser.NewRegistryFrom(csscolorparser.CSSColors(), colors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := csscolorparser.ParseWithContext("ElectricLime", &csscolorparser.ParseContext{Registry: r})
+	if err != nil || c.HexString() != "#a8ff04" {
+		t.Errorf("electric lime: %v %v", c.HexString(), err)
+	}
+
+	for _, s := range []string{"cloudy blue", "cloudy blue\t#acc2dx", "\t#acc2d9"} {
+		if _, err := Parse(strings.NewReader(s)); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+}
+
+func TestName(t *testing.T) {
+	for s, name := range map[string]string{
+		"robin's egg blue": "robinseggblue",
+		"Blue/Green":       "blue-green",
+		" puke green ":     "pukegreen",
+	} {
+		if n := Name(s); n != name {
+			t.Errorf("%q: expected %q, got %q", s, name, n)
+		}
+	}
+}
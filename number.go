@@ -0,0 +1,75 @@
+package csscolorparser
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Numeric tokens
+// https://www.w3.org/TR/css-syntax-3/#consume-number
+
+// scanNumber returns the length of the <number> at the start of s, or 0.
+// A leading sign is only accepted if sign is true.
+func scanNumber(s string, sign bool) int {
+	i := 0
+	if sign && i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	digits := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+		digits++
+	}
+	if i+1 < len(s) && s[i] == '.' && isDigit(s[i+1]) {
+		i++
+		for i < len(s) && isDigit(s[i]) {
+			i++
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0
+	}
+	// The exponent is only part of the number if digits follow
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			i = j
+		}
+	}
+	return i
+}
+
+// numberValue converts a token matched by scanNumber to a finite float64.
+// Numbers too large to represent are clamped.
+func numberValue(tok string) float64 {
+	f, _ := strconv.ParseFloat(tok, 64)
+	return math.Max(math.Min(f, math.MaxFloat64), -math.MaxFloat64)
+}
+
+// parseDimension parses a <number>, <percentage> or <dimension> token and
+// returns its value and unit: "" for numbers, "%" for percentages.
+func parseDimension(s string) (v float64, unit string, ok bool) {
+	n := scanNumber(s, true)
+	if n == 0 {
+		return 0, "", false
+	}
+	unit = s[n:]
+	if unit != "" && unit != "%" && !isIdent(unit) {
+		return 0, "", false
+	}
+	return numberValue(s[:n]), unit, true
+}
+
+// parseFloat parses a CSS <number>.
+func parseFloat(s string) (float64, bool) {
+	v, unit, ok := parseDimension(strings.TrimSpace(s))
+	return v, ok && unit == ""
+}
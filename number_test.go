@@ -0,0 +1,84 @@
+package csscolorparser
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_ParseDimension(t *testing.T) {
+	data := []struct {
+		s    string
+		v    float64
+		unit string
+	}{
+		{"0", 0, ""},
+		{".5", 0.5, ""},
+		{"-.5", -0.5, ""},
+		{"+1e2%", 100, "%"},
+		{"1e-3deg", 0.001, "deg"},
+		{"1E+2", 100, ""},
+		{"12.75turn", 12.75, "turn"},
+		{"1e", 1, "e"},
+		{"1em", 1, "em"},
+		{"2e-deg", 2, "e-deg"},
+		{"1e400", math.MaxFloat64, ""},
+		{"-1e400%", -math.MaxFloat64, "%"},
+		{"1e-400", 0, ""},
+	}
+	for _, d := range data {
+		v, unit, ok := parseDimension(d.s)
+		testTrue(t, ok)
+		test(t, v, d.v)
+		test(t, unit, d.unit)
+	}
+
+	invalid := []string{
+		"",
+		"inf",
+		"+Inf",
+		"Infinity",
+		"nan",
+		"NaN",
+		"0x1p3",
+		"0x10",
+		"1_000",
+		".",
+		"5.",
+		"+",
+		"--1",
+		"+-1",
+		"1.2.3",
+		"1%%",
+		"1e3.5",
+	}
+	for _, s := range invalid {
+		_, unit, ok := parseDimension(s)
+		if ok && unit == "" {
+			t.Errorf("%q: expected an invalid number", s)
+		}
+		_, ok = parseFloat(s)
+		testTrue(t, !ok)
+	}
+
+	colors := []struct {
+		s   string
+		hex string
+	}{
+		{"rgb(.5e3 +1e2% -0 / .5)", "#ffff0080"},
+		{"hsl(1.2e2 1e2% 5e1%)", "#00ff00"},
+		{"hsl(0.12e3deg 100% 50%)", "#00ff00"},
+		{"rgb(1e400 0 0)", "#ff0000"},
+		{"rgb(calc(1e3) 0 0)", "#ff0000"},
+	}
+	for _, d := range colors {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+	}
+
+	for _, s := range []string{"rgb(nan, 0, 0)", "rgb(inf 0 0)", "rgb(0x1p3 0 0)", "hsl(infinity 100% 50%)", "rgb(5. 0 0)"} {
+		c, err := Parse(s)
+		testTrue(t, err != nil)
+		testColor(t, c, Color{A: 1})
+	}
+}
@@ -0,0 +1,88 @@
+package csscolorparser
+
+import "math"
+
+// Profile describes the color syntax accepted by a browser engine. Set
+// ParseContext.Profile to parse colors like that engine does.
+// Profiles always use the strict syntax, see ParseContext.Strict.
+//
+// Profiles model which colors are accepted, and the 8-bit storage of the
+// result. Engine specific rounding of percentages and clamping order are
+// not modeled: the colors produced by Chromium 87 and Firefox 84 match the
+// parser once quantized.
+type Profile struct {
+	// Name of the engine, used in error messages.
+	Name string
+
+	// Functions are the color functions supported by the engine.
+	// If nil, all functions supported by the parser are accepted.
+	Functions map[string]bool
+
+	// None enables the `none` keyword.
+	None bool
+
+	// Relative enables the relative color syntax.
+	Relative bool
+
+	// LegacyUnits applies the number and percentage rules of the comma
+	// separated syntax to the space separated one too: rgb() components
+	// can't mix numbers and percentages, and the other components of hsl()
+	// and hwb() must be percentages. CSS Color 4 lifted these rules.
+	LegacyUnits bool
+
+	// Quantize rounds the color channels to 8 bits, like engines that
+	// store colors as 8-bit RGBA. Channels are clamped before rounding.
+	Quantize bool
+}
+
+// Chromium87 returns a profile that parses colors like Chromium 87: hex
+// colors, named colors, rgb(), rgba(), hsl() and hsla(), with the units of
+// CSS Color 3. Each call returns a new profile, which the caller may modify.
+func Chromium87() *Profile {
+	return &Profile{
+		Name: "Chromium 87",
+		Functions: map[string]bool{
+			"rgb":  true,
+			"rgba": true,
+			"hsl":  true,
+			"hsla": true,
+		},
+		LegacyUnits: true,
+		Quantize:    true,
+	}
+}
+
+// Firefox84 returns a profile that parses colors like Firefox 84: the same
+// colors as Chromium87, and hwb(). Each call returns a new profile.
+func Firefox84() *Profile {
+	return &Profile{
+		Name: "Firefox 84",
+		Functions: map[string]bool{
+			"rgb":  true,
+			"rgba": true,
+			"hsl":  true,
+			"hsla": true,
+			"hwb":  true,
+		},
+		LegacyUnits: true,
+		Quantize:    true,
+	}
+}
+
+// strict reports whether the strict syntax is used.
+func (p *parser) strict() bool {
+	return p.ctx.Strict || p.ctx.Profile != nil
+}
+
+// supports reports whether the profile, if any, supports the function.
+func (p *parser) supports(fname string) bool {
+	prof := p.ctx.Profile
+	return prof == nil || prof.Functions == nil || prof.Functions[fname]
+}
+
+func quantize(c Color) Color {
+	q := func(v float64) float64 {
+		return math.Round(clamp0_1(v)*255) / 255
+	}
+	return Color{q(c.R), q(c.G), q(c.B), q(c.A)}
+}
@@ -0,0 +1,96 @@
+package csscolorparser
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_Profiles(t *testing.T) {
+	tables := []struct {
+		profile *Profile
+		colors  []struct {
+			s          string
+			r, g, b, a uint8
+		}
+	}{
+		{Chromium87(), chromiumColors},
+		{Firefox84(), firefoxColors},
+	}
+	for _, tb := range tables {
+		ctx := &ParseContext{Profile: tb.profile}
+		for _, d := range tb.colors {
+			c, err := ParseWithContext(d.s, ctx)
+			test(t, err, nil)
+			// Quantized channels are exact
+			test(t, [4]float64{c.R * 255, c.G * 255, c.B * 255, c.A * 255},
+				[4]float64{float64(d.r), float64(d.g), float64(d.b), float64(d.a)})
+		}
+	}
+
+	invalid := []struct {
+		s    string
+		kind error
+	}{
+		{"ff0000", ErrUnknownName},
+		{"rgb(255, 0 0)", ErrInvalidFormat},
+		{"rgb(none 0 0)", ErrInvalidFormat},
+		{"rgb(from red r g b)", ErrInvalidFormat},
+		{"hsv(0 100% 100%)", ErrUnknownFunction},
+		{"lab(50 0 0)", ErrUnknownFunction},
+		{"oklch(0.5 0.1 90)", ErrUnknownFunction},
+		{"color(srgb 1 0 0)", ErrUnknownFunction},
+		{"color-mix(in srgb, red, blue)", ErrUnknownFunction},
+	}
+	for _, p := range []*Profile{Chromium87(), Firefox84()} {
+		ctx := &ParseContext{Profile: p}
+		for _, d := range invalid {
+			_, err := ParseWithContext(d.s, ctx)
+			if !errors.Is(err, d.kind) {
+				t.Errorf("%s %q: expected %v, got %v", p.Name, d.s, d.kind, err)
+			}
+		}
+	}
+
+	// hwb() is only supported by Firefox 84
+	_, err := ParseWithContext("hwb(0 0% 0%)", &ParseContext{Profile: Chromium87()})
+	testTrue(t, errors.Is(err, ErrUnknownFunction))
+	c, err := ParseWithContext("hwb(0 0% 0%)", &ParseContext{Profile: Firefox84()})
+	test(t, err, nil)
+	test(t, c.HexString(), "#ff0000")
+	_, err = ParseWithContext("hwb(0 0 0)", &ParseContext{Profile: Firefox84()})
+	testTrue(t, errors.Is(err, ErrBadUnit))
+
+	// Units of CSS Color 3
+	for _, p := range []*Profile{Chromium87(), Firefox84()} {
+		ctx := &ParseContext{Profile: p}
+		for _, s := range []string{"hsl(120 100 50)", "hsl(120 100% 50)", "rgb(255 50% 0)", "rgb(100% 0 0 / 50%)"} {
+			_, err := ParseWithContext(s, ctx)
+			if !errors.Is(err, ErrBadUnit) {
+				t.Errorf("%s %q: expected %v, got %v", p.Name, s, ErrBadUnit, err)
+			}
+		}
+		c, err := ParseWithContext("hsl(120 100% 50% / 50%)", ctx)
+		test(t, err, nil)
+		test(t, c.HexString(), "#00ff0080")
+		c, err = ParseWithContext("rgb(100% 0% 0% / 0.5)", ctx)
+		test(t, err, nil)
+		test(t, c.HexString(), "#ff000080")
+	}
+
+	// CSS Color 4 units without a profile
+	c, err = ParseWithContext("hsl(120 100 50)", &ParseContext{Strict: true})
+	test(t, err, nil)
+	test(t, c.HexString(), "#00ff00")
+
+	// Profiles are not shared
+	p := Chromium87()
+	p.Functions["hwb"] = true
+	_, err = ParseWithContext("hwb(0 0% 0%)", &ParseContext{Profile: Chromium87()})
+	testTrue(t, errors.Is(err, ErrUnknownFunction))
+
+	// Custom profile
+	modern := &Profile{Name: "modern", None: true, Relative: true}
+	c, err = ParseWithContext("oklch(from rgb(none 255 0) l c h / 0.3)", &ParseContext{Profile: modern})
+	test(t, err, nil)
+	test(t, c.A, 0.3)
+}
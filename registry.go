@@ -0,0 +1,337 @@
+package csscolorparser
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// Registry is a set of named colors, safe for concurrent use. The zero
+// value is an empty registry. Names are case-insensitive.
+type Registry struct {
+	mu      sync.RWMutex
+	colors  map[string]Color
+	aliases map[string]string // alias → name
+	seq     map[string]uint64 // Order in which names and aliases were added
+	next    uint64
+
+	// Reverse index, in preferred order, and the preferred name of each
+	// distinct color for Nearest. Rebuilt when index is nil.
+	index  map[[3]uint8][]string
+	points []namedPoint
+}
+
+type namedPoint struct {
+	name  string
+	oklab [3]float64
+}
+
+// DefaultRegistry holds the named colors used by Parse and Color.Name.
+// It starts with the CSS named colors.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry returns a registry with the CSS named colors.
+func NewRegistry() *Registry {
+	r := &Registry{}
+	r.Merge(CSSColors())
+	return r
+}
+
+// CSSColors returns the CSS named colors.
+func CSSColors() map[string]Color {
+	res := make(map[string]Color, len(namedColors))
+	for name, c := range namedColors {
+		res[name] = Color{float64(c[0]) / 255, float64(c[1]) / 255, float64(c[2]) / 255, 1}
+	}
+	return res
+}
+
+// NewRegistryFrom returns a registry with the colors of several
+// dictionaries, e.g. CSSColors and the ones of the names/x11 package.
+// When dictionaries define the same name, the last one wins. For the
+// reverse lookup, names of earlier dictionaries are preferred.
+func NewRegistryFrom(dicts ...map[string]Color) (*Registry, error) {
+	r := &Registry{}
+	for _, d := range dicts {
+		if err := r.Merge(d); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Merge adds the colors of a dictionary, replacing the colors of existing
+// names. New names are added in alphabetical order. If a name is invalid,
+// nothing is added.
+func (r *Registry) Merge(dict map[string]Color) error {
+	names := make([]string, 0, len(dict))
+	lower := make(map[string]Color, len(dict))
+	for name, c := range dict {
+		s, err := checkName(name)
+		if err != nil {
+			return err
+		}
+		names = append(names, s)
+		lower[s] = c
+	}
+	// Alphabetical order prefers aqua to cyan, fuchsia to magenta
+	// and gray to grey.
+	sort.Strings(names)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.colors == nil {
+		r.colors = make(map[string]Color, len(names))
+	}
+	for _, name := range names {
+		delete(r.aliases, name)
+		r.colors[name] = lower[name]
+		r.added(name)
+	}
+	return nil
+}
+
+// added records the position of a new name and invalidates the index.
+func (r *Registry) added(name string) {
+	if r.seq == nil {
+		r.seq = map[string]uint64{}
+	}
+	if _, ok := r.seq[name]; !ok {
+		r.seq[name] = r.next
+		r.next++
+	}
+	r.index = nil
+}
+
+// Keywords that can't be used as color names
+var reservedNames = map[string]bool{
+	"transparent":  true,
+	"currentcolor": true,
+	"none":         true,
+	"from":         true,
+}
+
+func checkName(name string) (string, error) {
+	s := asciiLower(name)
+	if !isIdent(s) || reservedNames[s] {
+		return "", &ParseError{Kind: ErrInvalidFormat, Input: name, Token: name, Detail: "invalid color name"}
+	}
+	return s, nil
+}
+
+// Add adds a named color, or replaces the color of an existing name.
+// The name must be a CSS identifier, e.g. "acme-blue".
+func (r *Registry) Add(name string, c Color) error {
+	s, err := checkName(name)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.colors == nil {
+		r.colors = map[string]Color{}
+	}
+	delete(r.aliases, s)
+	r.colors[s] = c
+	r.added(s)
+	return nil
+}
+
+// Alias adds alias as another name for the existing color name. The alias
+// follows later changes to name, and is removed with it.
+func (r *Registry) Alias(alias, name string) error {
+	a, err := checkName(alias)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	target := asciiLower(name)
+	if t, ok := r.aliases[target]; ok {
+		target = t
+	}
+	if _, ok := r.colors[target]; !ok {
+		return &ParseError{Kind: ErrUnknownName, Input: name, Token: name}
+	}
+	if a == target {
+		return &ParseError{Kind: ErrInvalidFormat, Input: alias, Token: alias, Detail: "a name can't be an alias of itself"}
+	}
+	if r.aliases == nil {
+		r.aliases = map[string]string{}
+	}
+	delete(r.colors, a)
+	for x, t := range r.aliases {
+		if t == a {
+			r.aliases[x] = target
+		}
+	}
+	r.aliases[a] = target
+	r.added(a)
+	return nil
+}
+
+// Remove removes a name or alias. Removing a name also removes its aliases.
+func (r *Registry) Remove(name string) {
+	s := asciiLower(name)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.index = nil
+	delete(r.seq, s)
+	if _, ok := r.aliases[s]; ok {
+		delete(r.aliases, s)
+		return
+	}
+	delete(r.colors, s)
+	for a, t := range r.aliases {
+		if t == s {
+			delete(r.aliases, a)
+			delete(r.seq, a)
+		}
+	}
+}
+
+// Lookup returns the color of a name or alias.
+func (r *Registry) Lookup(name string) (Color, bool) {
+	s := asciiLower(name)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if t, ok := r.aliases[s]; ok {
+		s = t
+	}
+	c, ok := r.colors[s]
+	return c, ok
+}
+
+// Names returns all names and aliases, sorted.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.colors)+len(r.aliases))
+	for name := range r.colors {
+		names = append(names, name)
+	}
+	for a := range r.aliases {
+		names = append(names, a)
+	}
+	r.mu.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
+// Name returns the preferred name of the color, comparing colors as
+// 8-bit RGB. See NamesOf.
+func (r *Registry) Name(c Color) (string, bool) {
+	names := r.lookupIndex(c)
+	if len(names) == 0 {
+		return "", false
+	}
+	return names[0], true
+}
+
+// NamesOf returns all names and aliases of the color, comparing colors as
+// 8-bit RGB. Names come before aliases, each in the order they were added.
+// The CSS named colors are added in alphabetical order.
+func (r *Registry) NamesOf(c Color) []string {
+	names := r.lookupIndex(c)
+	return append([]string(nil), names...)
+}
+
+func rgbKey(c Color) [3]uint8 {
+	r, g, b, _ := c.RGBA255()
+	return [3]uint8{r, g, b}
+}
+
+func (r *Registry) lookupIndex(c Color) []string {
+	key := rgbKey(c)
+	r.mu.RLock()
+	if r.index != nil {
+		names := r.index[key]
+		r.mu.RUnlock()
+		return names
+	}
+	r.mu.RUnlock()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.index == nil {
+		r.buildIndex()
+	}
+	return r.index[key]
+}
+
+func (r *Registry) buildIndex() {
+	names := make([]string, 0, len(r.colors)+len(r.aliases))
+	for name := range r.colors {
+		names = append(names, name)
+	}
+	for a := range r.aliases {
+		names = append(names, a)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		_, ai := r.aliases[names[i]]
+		_, aj := r.aliases[names[j]]
+		if ai != aj {
+			return aj
+		}
+		return r.seq[names[i]] < r.seq[names[j]]
+	})
+
+	r.index = make(map[[3]uint8][]string, len(names))
+	r.points = r.points[:0]
+	for _, name := range names {
+		c, ok := r.colors[name]
+		if !ok {
+			c = r.colors[r.aliases[name]]
+		}
+		key := rgbKey(c)
+		if _, ok := r.index[key]; !ok {
+			r.points = append(r.points, namedPoint{name, toOklab(c)})
+		}
+		r.index[key] = append(r.index[key], name)
+	}
+}
+
+func toOklab(c Color) [3]float64 {
+	v, _ := toChannels("oklab", c)
+	return v
+}
+
+// Nearest returns the preferred name of the closest color in the registry
+// and its distance, the Euclidean distance in Oklab (ΔEOK). Alpha is ignored.
+// It returns false if the registry is empty.
+func (r *Registry) Nearest(c Color) (name string, distance float64, ok bool) {
+	lab := toOklab(c)
+	r.mu.RLock()
+	if r.index == nil {
+		r.mu.RUnlock()
+		r.mu.Lock()
+		if r.index == nil {
+			r.buildIndex()
+		}
+		r.mu.Unlock()
+		r.mu.RLock()
+	}
+	defer r.mu.RUnlock()
+
+	distance = math.Inf(1)
+	for _, p := range r.points {
+		d := math.Sqrt(sq(lab[0]-p.oklab[0]) + sq(lab[1]-p.oklab[1]) + sq(lab[2]-p.oklab[2]))
+		if d < distance {
+			name, distance = p.name, d
+		}
+	}
+	if name == "" {
+		return "", 0, false
+	}
+	return name, distance, true
+}
+
+func sq(x float64) float64 {
+	return x * x
+}
+
+func (p *parser) registry() *Registry {
+	if p.ctx.Registry != nil {
+		return p.ctx.Registry
+	}
+	return DefaultRegistry
+}
@@ -0,0 +1,204 @@
+package csscolorparser
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func Test_Registry(t *testing.T) {
+	r := NewRegistry()
+	test(t, len(r.Names()), len(namedColors))
+
+	acme := Color{0, 0.4, 0.8, 1}
+	test(t, r.Add("Acme-Blue", acme), nil)
+	test(t, r.Alias("acme-primary", "acme-blue"), nil)
+	test(t, r.Alias("acme-link", "ACME-PRIMARY"), nil)
+
+	c, ok := r.Lookup("ACME-BLUE")
+	testTrue(t, ok)
+	test(t, c, acme)
+	c, ok = r.Lookup("acme-link")
+	testTrue(t, ok)
+	test(t, c, acme)
+
+	name, ok := r.Name(Color{0, 0.4, 0.8, 0.5})
+	testTrue(t, ok)
+	test(t, name, "acme-blue")
+
+	// Aliases follow changes
+	test(t, r.Add("acme-blue", Color{0, 0, 1, 1}), nil)
+	c, _ = r.Lookup("acme-primary")
+	test(t, c.HexString(), "#0000ff")
+
+	// Parsing with the registry
+	ctx := &ParseContext{Registry: r}
+	c, err := ParseWithContext("rgb(from acme-primary r g b / 50%)", ctx)
+	test(t, err, nil)
+	test(t, c.HexString(), "#0000ff80")
+	_, err = Parse("acme-blue")
+	testTrue(t, errors.Is(err, ErrUnknownName))
+
+	// Remove
+	r.Remove("acme-link")
+	_, ok = r.Lookup("acme-link")
+	testTrue(t, !ok)
+	_, ok = r.Lookup("acme-primary")
+	testTrue(t, ok)
+	r.Remove("acme-blue")
+	_, ok = r.Lookup("acme-primary")
+	testTrue(t, !ok)
+	r.Remove("red")
+	_, err = ParseWithContext("red", ctx)
+	testTrue(t, errors.Is(err, ErrUnknownName))
+	test(t, len(r.Names()), len(namedColors)-1)
+
+	// Replacing an alias with a color
+	test(t, r.Alias("brand", "gold"), nil)
+	test(t, r.Add("brand", Color{1, 0, 0, 1}), nil)
+	c, _ = r.Lookup("brand")
+	test(t, c.HexString(), "#ff0000")
+	c, _ = r.Lookup("gold")
+	test(t, c.HexString(), "#ffd700")
+
+	// Invalid names
+	for _, name := range []string{"", "acme blue", "1st", "#fff", "transparent", "CurrentColor", "none"} {
+		testTrue(t, errors.Is(r.Add(name, acme), ErrInvalidFormat))
+	}
+	testTrue(t, errors.Is(r.Alias("x", "undefined"), ErrUnknownName))
+	testTrue(t, errors.Is(r.Alias("gold", "gold"), ErrInvalidFormat))
+
+	// The zero value is an empty registry
+	var empty Registry
+	_, ok = empty.Lookup("red")
+	testTrue(t, !ok)
+	test(t, empty.Add("red", Color{1, 0, 0, 1}), nil)
+	name, ok = empty.Name(Color{1, 0, 0, 1})
+	testTrue(t, ok)
+	test(t, name, "red")
+
+	// Concurrent use
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				r.Add("tmp", Color{0, 0, 0, 1})
+				ParseWithContext("tmp", ctx)
+				r.Name(Color{0, 0, 0, 1})
+				r.Remove("tmp")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_RegistryNamesOf(t *testing.T) {
+	r := NewRegistry()
+	test(t, r.Add("acme-blue", Color{0, 0, 1, 1}), nil)
+	test(t, r.Alias("acme-primary", "acme-blue"), nil)
+	test(t, r.Alias("primary", "blue"), nil)
+	test(t, r.Add("acme-navy", Color{0, 0, 1, 1}), nil)
+
+	test(t, strings.Join(r.NamesOf(Color{0, 0, 1, 1}), " "), "blue acme-blue acme-navy acme-primary primary")
+	name, _ := r.Name(Color{0, 0, 1, 0.5})
+	test(t, name, "blue")
+
+	r.Remove("blue")
+	test(t, strings.Join(r.NamesOf(Color{0, 0, 1, 1}), " "), "acme-blue acme-navy acme-primary")
+
+	// Changing the color keeps the position
+	test(t, r.Add("acme-blue", Color{0, 0, 0.5, 1}), nil)
+	test(t, r.Add("acme-blue", Color{0, 0, 1, 1}), nil)
+	test(t, strings.Join(r.NamesOf(Color{0, 0, 1, 1}), " "), "acme-blue acme-navy acme-primary")
+
+	// The returned slice is a copy
+	names := r.NamesOf(Color{0, 0, 1, 1})
+	names[0] = "x"
+	name, _ = r.Name(Color{0, 0, 1, 1})
+	test(t, name, "acme-blue")
+}
+
+func Test_DefaultRegistry(t *testing.T) {
+	defer DefaultRegistry.Remove("acme-warning")
+	test(t, DefaultRegistry.Add("acme-warning", Color{1, 0.5, 0, 1}), nil)
+
+	c, err := Parse("acme-warning")
+	test(t, err, nil)
+	test(t, c.HexString(), "#ff8000")
+	name, ok := c.Name()
+	testTrue(t, ok)
+	test(t, name, "acme-warning")
+}
+
+func Test_Nearest(t *testing.T) {
+	data := []struct {
+		hex  string
+		name string
+	}{
+		{"#4682b4", "steelblue"},
+		{"#4783b5", "steelblue"},
+		{"#fe0102", "red"},
+		{"#00fffe", "aqua"},
+		{"#7f7f7f", "gray"},
+		{"#010101", "black"},
+		{"#fffffe", "white"},
+		{"#ff8a05", "darkorange"},
+	}
+	for _, d := range data {
+		c, _ := Parse(d.hex)
+		name, dist := c.NearestName()
+		test(t, name, d.name)
+		testTrue(t, dist < 0.1)
+	}
+
+	c, _ := Parse("steelblue")
+	name, dist := c.NearestName()
+	test(t, name, "steelblue")
+	test(t, dist, 0.0)
+
+	// Custom registry
+	var r Registry
+	_, _, ok := r.Nearest(Color{1, 0, 0, 1})
+	testTrue(t, !ok)
+	r.Add("dark", Color{0.1, 0.1, 0.1, 1})
+	r.Add("light", Color{0.9, 0.9, 0.9, 1})
+	name, _, ok = r.Nearest(Color{0.3, 0.3, 0.3, 1})
+	testTrue(t, ok)
+	test(t, name, "dark")
+	r.Add("mid", Color{0.4, 0.4, 0.4, 1})
+	name, _, _ = r.Nearest(Color{0.3, 0.3, 0.3, 1})
+	test(t, name, "mid")
+}
+
+func Test_RegistryFrom(t *testing.T) {
+	css := CSSColors()
+	test(t, len(css), len(namedColors))
+
+	custom := map[string]Color{
+		"Gray":      {0.5, 0.5, 0.5, 1},
+		"acme-blue": {0, 0, 1, 1},
+	}
+	r, err := NewRegistryFrom(css, custom)
+	test(t, err, nil)
+	c, _ := r.Lookup("gray")
+	test(t, c.HexString(), "#808080")
+	name, _ := r.Name(Color{0, 0, 1, 1})
+	test(t, name, "blue")
+
+	r, err = NewRegistryFrom(custom, css)
+	test(t, err, nil)
+	name, _ = r.Name(Color{0, 0, 1, 1})
+	test(t, name, "acme-blue")
+
+	r, err = NewRegistryFrom(map[string]Color{"not a name": {}})
+	testTrue(t, r == nil)
+	testTrue(t, errors.Is(err, ErrInvalidFormat))
+
+	r = NewRegistry()
+	testTrue(t, r.Merge(map[string]Color{"ok": {}, "1bad": {}}) != nil)
+	_, ok := r.Lookup("ok")
+	testTrue(t, !ok)
+}
@@ -0,0 +1,120 @@
+package csscolorparser
+
+import "strconv"
+
+// Relative color syntax
+// https://www.w3.org/TR/css-color-5/#relative-colors
+
+var relativeChannels = map[string][3]string{
+	"rgb":   {"r", "g", "b"},
+	"rgba":  {"r", "g", "b"},
+	"hsl":   {"h", "s", "l"},
+	"hsla":  {"h", "s", "l"},
+	"hwb":   {"h", "w", "b"},
+	"hwba":  {"h", "w", "b"},
+	"hsv":   {"h", "s", "v"},
+	"hsva":  {"h", "s", "v"},
+	"lab":   {"l", "a", "b"},
+	"lch":   {"l", "c", "h"},
+	"oklab": {"l", "a", "b"},
+	"oklch": {"l", "c", "h"},
+}
+
+// resolveRelative converts the parameters of a relative color, starting
+// with "from", into the parameters of the equivalent absolute color.
+// offsets are the positions of params in p.input.
+func (p *parser) resolveRelative(fname string, params []string, offsets []int) ([]string, []int, error) {
+	if len(params) < 2 {
+		return nil, nil, p.failf(ErrWrongArity, offsets[0], params[0], "missing origin color")
+	}
+	origin, _, err := p.parseColor(params[1], offsets[1])
+	if err != nil {
+		return nil, nil, err
+	}
+	end := offsets[1] + len(params[1])
+	params, offsets = params[2:], offsets[2:]
+
+	var (
+		res      []string
+		resOff   []int
+		names    [3]string
+		values   [3]float64
+		ok       bool
+		percents [3]bool
+	)
+
+	if fname == "color" {
+		if len(params) == 0 {
+			return nil, nil, p.failf(ErrWrongArity, end, "", "missing color space")
+		}
+		space := params[0]
+		values, ok = toPredefined(space, origin)
+		if !ok {
+			return nil, nil, p.fail(ErrUnknownColorSpace, offsets[0], space)
+		}
+		names = [3]string{"r", "g", "b"}
+		if space == "xyz" || space == "xyz-d50" || space == "xyz-d65" {
+			names = [3]string{"x", "y", "z"}
+		}
+		res = append(res, space)
+		resOff = append(resOff, offsets[0])
+		params, offsets = params[1:], offsets[1:]
+	} else {
+		values, _ = toChannels(fname, origin)
+		names = relativeChannels[fname]
+		// Plain numbers are percentages for these channels
+		switch fname {
+		case "hsl", "hsla", "hwb", "hwba", "hsv", "hsva":
+			percents = [3]bool{false, true, true}
+		}
+	}
+
+	if len(params) != 3 && len(params) != 4 {
+		return nil, nil, p.failf(ErrWrongArity, end, "", "expected 3 or 4 components, got %d", len(params))
+	}
+
+	vars := map[string]float64{
+		names[0]: values[0],
+		names[1]: values[1],
+		names[2]: values[2],
+		"alpha":  origin.A,
+	}
+
+	for i, param := range params {
+		resOff = append(resOff, offsets[i])
+		v, isNum := vars[param]
+		if !isNum && isMathFunction(param) {
+			cv, err := evalMath(param, vars)
+			if err != nil {
+				return nil, nil, p.at(err, offsets[i])
+			}
+			v = cv.v
+			switch cv.unit {
+			case unitPercent:
+				res = append(res, strconv.FormatFloat(v, 'f', -1, 64)+"%")
+				continue
+			case unitAngle:
+				res = append(res, strconv.FormatFloat(v, 'f', -1, 64)+"deg")
+				continue
+			}
+			isNum = true
+		}
+		if !isNum {
+			v, isNum = parseFloat(param)
+		}
+		if !isNum {
+			res = append(res, param)
+			continue
+		}
+		param = strconv.FormatFloat(v, 'f', -1, 64)
+		if i < 3 && percents[i] {
+			param += "%"
+		}
+		res = append(res, param)
+	}
+	if len(params) == 3 {
+		res = append(res, strconv.FormatFloat(origin.A, 'f', -1, 64))
+		resOff = append(resOff, offsets[2])
+	}
+	return res, resOff, nil
+}
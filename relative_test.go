@@ -0,0 +1,68 @@
+package csscolorparser
+
+import "testing"
+
+func Test_RelativeColor(t *testing.T) {
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"rgb(from red r g b)", "#ff0000"},
+		{"rgb(from #123456 b g r)", "#563412"},
+		{"rgba(from #123456 r g b / 50%)", "#12345680"},
+		{"rgb(from rgb(255 0 0 / 0.5) r g b)", "#ff000080"},
+		{"rgb(from rgb(255 0 0 / 0.5) r g b / calc(alpha * 2))", "#ff0000"},
+		{"rgb(from red calc(r / 5) 0 calc(r - 55))", "#3300c8"},
+		{"rgb(from red none g b)", "#000000"},
+		{"hsl(from red calc(h + 120) s l)", "#00ff00"},
+		{"hsl(from red h 50 l)", "#bf4040"},
+		{"hsl(from red h 50% l)", "#bf4040"},
+		{"hwb(from #808080 h w b)", "#808080"},
+		{"hwb(from red h calc(w + 50) b)", "#ff8080"},
+		{"hsv(from lime h s calc(v / 2))", "#008000"},
+		{"lab(from #7654cd l a b)", "#7654cd"},
+		{"lch(from #7654cd l c h)", "#7654cd"},
+		{"oklab(from #7654cd l a b)", "#7654cd"},
+		{"oklch(from #7654cd l c h)", "#7654cd"},
+		{"oklch(from red l c h / 0.2)", "#ff000033"},
+		{"color(from red srgb r g b)", "#ff0000"},
+		{"color(from #7654cd display-p3 r g b)", "#7654cd"},
+		{"color(from #7654cd rec2020 r g b)", "#7654cd"},
+		{"color(from #7654cd prophoto-rgb r g b)", "#7654cd"},
+		{"color(from #7654cd a98-rgb r g b)", "#7654cd"},
+		{"color(from #7654cd xyz-d50 x y z)", "#7654cd"},
+		{"color(from #7654cd xyz x y z / alpha)", "#7654cd"},
+		{"color(from red srgb b g r)", "#0000ff"},
+		{"rgb(from hsl(from red calc(h + 240) s l) r g b)", "#0000ff"},
+		{"RGB(FROM Red R G B)", "#ff0000"},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+	}
+
+	c, err := Parse("oklch(from red calc(l - 0.1) c h)")
+	test(t, err, nil)
+	l, a, b := linearRgbToOklab(1, 0, 0)
+	test(t, c.HexString(), FromOklab(l-0.1, a, b, 1).HexString())
+
+	invalid := []string{
+		"rgb(from)",
+		"rgb(from red)",
+		"rgb(from red r g)",
+		"rgb(from red r g b a 1)",
+		"rgb(from bloodred r g b)",
+		"rgb(from red x g b)",
+		"rgb(from red calc(r +) g b)",
+		"rgb(from red calc(x) g b)",
+		"rgb(from red calc(r g b)",
+		"color(from red foo r g b)",
+		"color(from red xyz r g b)",
+		"color(from red srgb x y z)",
+	}
+	for _, s := range invalid {
+		_, err := Parse(s)
+		testTrue(t, err != nil)
+	}
+}
@@ -0,0 +1,146 @@
+package csscolorparser
+
+import "strings"
+
+// Strict mode, see ParseContext.Strict
+// https://www.w3.org/TR/css-color-4/#color-syntax
+
+// Functions accepted only in lenient mode
+var nonStandardFunctions = map[string]bool{
+	"hsv":  true,
+	"hsva": true,
+	"hwba": true,
+}
+
+// Functions with a legacy, comma separated syntax
+var legacyFunctions = map[string]bool{
+	"rgb":  true,
+	"rgba": true,
+	"hsl":  true,
+	"hsla": true,
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// checkSeparators checks the separators between the arguments of fname,
+// which start at offset in p.input, and reports whether they use the
+// legacy comma syntax.
+func (p *parser) checkSeparators(fname, args string, offset int) (legacy bool, err error) {
+	var (
+		seps    []string // Separators before each argument, without whitespace
+		sepOffs []int
+		cur     string
+		curOff  = -1
+		depth   = 0
+		inArg   = false
+	)
+	for i := 0; i < len(args); i++ {
+		c := args[i]
+		if depth == 0 && (c == ',' || c == '/' || isSpace(c)) {
+			inArg = false
+			if !isSpace(c) {
+				if curOff == -1 {
+					curOff = i
+				}
+				cur += string(c)
+			}
+			continue
+		}
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if !inArg {
+			if curOff == -1 {
+				curOff = i
+			}
+			seps = append(seps, cur)
+			sepOffs = append(sepOffs, offset+curOff)
+			cur, curOff = "", -1
+			inArg = true
+		}
+	}
+	if cur != "" {
+		return false, p.failf(ErrInvalidFormat, offset+curOff, cur, "unexpected separator at the end of %s()", fname)
+	}
+	if len(seps) == 0 {
+		return false, nil
+	}
+	if seps[0] != "" {
+		return false, p.failf(ErrInvalidFormat, sepOffs[0], seps[0], "unexpected separator at the start of %s()", fname)
+	}
+
+	for _, sep := range seps[1:] {
+		if sep == "," {
+			legacy = true
+		}
+	}
+	if legacy && (!legacyFunctions[fname] || strings.HasPrefix(args[sepOffs[0]-offset:], "from")) {
+		return false, p.failf(ErrInvalidFormat, sepOffs[0], args, "%s() does not accept commas", fname)
+	}
+
+	if !legacy {
+		// The alpha must follow a slash
+		alpha := 3
+		if strings.HasPrefix(args[sepOffs[0]-offset:], "from") {
+			alpha += 2
+		}
+		if fname == "color" || fname == "device-cmyk" {
+			alpha++
+		}
+		if alpha < len(seps) && seps[alpha] != "/" {
+			return false, p.failf(ErrInvalidFormat, sepOffs[alpha], seps[alpha], "expected '/' before alpha")
+		}
+	}
+
+	for i := 1; i < len(seps); i++ {
+		switch sep := seps[i]; {
+		case legacy && sep != ",":
+			return false, p.failf(ErrInvalidFormat, sepOffs[i], sep, "expected a comma")
+		case !legacy && sep == "/" && i != len(seps)-1:
+			return false, p.failf(ErrInvalidFormat, sepOffs[i], sep, "'/' is only allowed before alpha")
+		case sep != "" && sep != "," && sep != "/":
+			return false, p.failf(ErrInvalidFormat, sepOffs[i], sep, "unexpected separator")
+		}
+	}
+	return legacy, nil
+}
+
+// checkLegacyUnits checks the number and percentage rules of the legacy
+// syntax: rgb() components are all numbers or all percentages, hsl()
+// saturation and lightness and hwb() whiteness and blackness are
+// percentages.
+func (p *parser) checkLegacyUnits(fname string, params []string, offsets []int) error {
+	switch fname {
+	case "rgb", "rgba":
+		unit := paramUnit(params[0])
+		for i := 1; i < 3; i++ {
+			if paramUnit(params[i]) != unit {
+				return p.failf(ErrBadUnit, offsets[i], params[i], "expected a %s like the first component", unit)
+			}
+		}
+	case "hsl", "hsla", "hwb", "hwba":
+		for i := 1; i < 3; i++ {
+			if paramUnit(params[i]) != unitPercent {
+				return p.failf(ErrBadUnit, offsets[i], params[i], "expected a percentage")
+			}
+		}
+	}
+	return nil
+}
+
+// paramUnit returns the type of a valid component.
+func paramUnit(s string) calcUnit {
+	if isMathFunction(s) {
+		v, _ := evalMath(s, nil)
+		return v.unit
+	}
+	if strings.HasSuffix(s, "%") {
+		return unitPercent
+	}
+	return unitNumber
+}
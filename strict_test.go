@@ -0,0 +1,110 @@
+package csscolorparser
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_Strict(t *testing.T) {
+	strict := &ParseContext{Strict: true}
+
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"#ff0000", "#ff0000"},
+		{"red", "#ff0000"},
+		{"rgb(255, 0, 0)", "#ff0000"},
+		{"rgba(100%, 0%, 0%, 0.5)", "#ff000080"},
+		{"rgb(255 0 0 / 50%)", "#ff000080"},
+		{"rgb(100% 0 0)", "#ff0000"},
+		{"rgb( 255 , 0 , 0 )", "#ff0000"},
+		{"hsl(120, 100%, 50%)", "#00ff00"},
+		{"hsl(120deg 100 50)", "#00ff00"},
+		{"hsl(120 100% 25 / 0.5)", "#00800080"},
+		{"hsl(none 0% 100%)", "#ffffff"},
+		{"hwb(0 0 0)", "#ff0000"},
+		{"hwb(240 20 20)", "#3333cc"},
+		{"rgb(from red r g b / alpha)", "#ff0000"},
+		{"hsl(from red h s l)", "#ff0000"},
+		{"color(srgb 1 0 0 / 0.5)", "#ff000080"},
+		{"color-mix(in srgb, red, blue)", "#800080"},
+		{"rgb(calc(255) 0 0)", "#ff0000"},
+	}
+	for _, d := range data {
+		c, err := ParseWithContext(d.s, strict)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+	}
+
+	invalid := []struct {
+		s      string
+		kind   error
+		offset int
+	}{
+		{"ff0000", ErrUnknownName, 0},
+		{"123", ErrInvalidFormat, 0},
+		{"blue123", ErrUnknownName, 0},
+		{"hsv(0 100% 100%)", ErrUnknownFunction, 0},
+		{"hwba(0 0% 0% 1)", ErrUnknownFunction, 0},
+		{"rgb(255, 0 0)", ErrInvalidFormat, 11},
+		{"rgb(255 0, 0)", ErrInvalidFormat, 8},
+		{"rgb(255, 0, 0 / 0.5)", ErrInvalidFormat, 14},
+		{"rgb(255 / 0 0)", ErrInvalidFormat, 8},
+		{"rgb(255 0 0 0.5)", ErrInvalidFormat, 12},
+		{"color(srgb 1 0 0 0.5)", ErrInvalidFormat, 17},
+		{"rgb(from red r g b alpha)", ErrInvalidFormat, 19},
+		{"rgb(255,,0,0)", ErrInvalidFormat, 7},
+		{"rgb(,255,0,0)", ErrInvalidFormat, 4},
+		{"rgb(255,0,0,)", ErrInvalidFormat, 11},
+		{"rgb(255, 0%, 0)", ErrBadUnit, 9},
+		{"rgb(none, 0, 0)", ErrInvalidFormat, 4},
+		{"hsl(120, 100, 50%)", ErrBadUnit, 9},
+		{"hwb(0, 0%, 0%)", ErrInvalidFormat, 4},
+		{"lab(50, 0, 0)", ErrInvalidFormat, 4},
+		{"color(srgb, 1, 0, 0)", ErrInvalidFormat, 6},
+		{"rgb(from red, r, g, b)", ErrInvalidFormat, 4},
+	}
+	for _, d := range invalid {
+		c, err := ParseWithContext(d.s, strict)
+		testColor(t, c, Color{A: 1})
+		if !errors.Is(err, d.kind) {
+			t.Errorf("%q: expected %v, got %v", d.s, d.kind, err)
+			continue
+		}
+		test(t, err.(*ParseError).Offset, d.offset)
+	}
+
+	// Lenient mode accepts all of these
+	for _, s := range []string{"ff0000", "hsv(0 100% 100%)", "rgb(255, 0 0)", "rgb(255 / 0 0)", "rgb(255, 0%, 0)", "lab(50, 0, 0)"} {
+		_, err := Parse(s)
+		test(t, err, nil)
+	}
+}
+
+func Test_Whitespace(t *testing.T) {
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"rgb(255\t0\t0)", "#ff0000"},
+		{"rgb(255\n0\n0)", "#ff0000"},
+		{"rgb(\r\n255,\n0,\n0\n)", "#ff0000"},
+		{"rgb(255 0 0\t/\t50%)", "#ff000080"},
+		{"hsl(120deg\f100%\f50%)", "#00ff00"},
+		{"color(\tdisplay-p3\t1\t0\t0)", "#ff0000"},
+		{"rgb(calc(255\t*\n1) 0 0)", "#ff0000"},
+		{"rgb(from\tred\tr g b)", "#ff0000"},
+		{"color-mix(in\tsrgb,\tred\t40%,\nblue)", "#660099"},
+	}
+	for _, strict := range []bool{false, true} {
+		for _, d := range data {
+			c, err := ParseWithContext(d.s, &ParseContext{Strict: strict})
+			test(t, err, nil)
+			test(t, c.HexString(), d.hex)
+		}
+	}
+
+	_, err := ParseWithContext("rgb(255,\t0\t0)", &ParseContext{Strict: true})
+	testTrue(t, errors.Is(err, ErrInvalidFormat))
+}
@@ -0,0 +1,104 @@
+package csscolorparser
+
+import (
+	"sort"
+	"strings"
+)
+
+// Maximum number of suggestions of Registry.Suggest
+const maxSuggestions = 5
+
+// Suggest returns the names closest to a misspelled color name, best first.
+// Names are ranked by edit distance, after ignoring case, separators,
+// trailing digits and the gray/grey spelling. A name that starts with the
+// misspelled one, like lightgoldenrodyellow for lightgoldenrod, also matches.
+func (r *Registry) Suggest(name string) []string {
+	input := asciiLower(strings.TrimSpace(name))
+	norm := normalizeName(input)
+	if norm == "" {
+		return nil
+	}
+	maxDist := len(norm) / 3
+	if maxDist < 2 {
+		maxDist = 2
+	}
+
+	type candidate struct {
+		name       string
+		score, raw int
+	}
+	var found []candidate
+	for _, n := range r.Names() {
+		if n == input {
+			continue
+		}
+		cn := normalizeName(n)
+		score := editDistance(norm, cn)
+		if len(norm) >= 4 && (strings.HasPrefix(cn, norm) || strings.HasPrefix(norm, cn)) && score > 1 {
+			score = 1
+		}
+		if score <= maxDist {
+			found = append(found, candidate{n, score, editDistance(input, n)})
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		a, b := found[i], found[j]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		if a.raw != b.raw {
+			return a.raw < b.raw
+		}
+		return a.name < b.name
+	})
+
+	var res []string
+	for i := 0; i < len(found) && i < maxSuggestions; i++ {
+		res = append(res, found[i].name)
+	}
+	return res
+}
+
+// normalizeName removes the differences ignored by Suggest.
+func normalizeName(s string) string {
+	s = strings.TrimRight(s, "0123456789")
+	s = strings.NewReplacer("-", "", "_", "", " ", "", "grey", "gray").Replace(s)
+	return s
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b: the number of insertions, deletions, substitutions and transpositions
+// of adjacent bytes.
+func editDistance(a, b string) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && prev2[j-2]+1 < cur[j] {
+				cur[j] = prev2[j-2] + 1
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
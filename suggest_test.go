@@ -0,0 +1,51 @@
+package csscolorparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_Suggest(t *testing.T) {
+	data := []struct {
+		s           string
+		suggestions string
+	}{
+		{"lightgoldenrod", "lightgoldenrodyellow"},
+		{"darkgrey3", "darkgrey darkgray"},
+		{"grey50", "grey gray"},
+		{"Light-Blue", "lightblue lightskyblue"},
+		{"dark_sea_green", "darkseagreen darkgreen seagreen"},
+		{"cornflower", "cornflowerblue"},
+		{"fuschia", "fuchsia"},
+		{"redd", "red"},
+		{"lavendar", "lavender"},
+		{"burlywod", "burlywood"},
+		{"bloodred", ""},
+		{"xyz", ""},
+	}
+	for _, d := range data {
+		_, err := Parse(d.s)
+		var e *ParseError
+		testTrue(t, errors.As(err, &e))
+		testTrue(t, errors.Is(err, ErrUnknownName))
+		test(t, strings.Join(e.Suggestions, " "), d.suggestions)
+	}
+
+	_, err := Parse("lavendar")
+	test(t, err.Error(), `unknown color name "lavendar" at offset 0 in "lavendar"; did you mean "lavender"?`)
+	_, err = Parse("grey50")
+	test(t, err.Error(), `unknown color name "grey50" at offset 0 in "grey50"; did you mean "grey" (or "gray")?`)
+
+	// Suggestions come from the registry used for parsing
+	r := NewRegistry()
+	r.Add("acme-warning", Color{1, 0.5, 0, 1})
+	_, err = ParseWithContext("acme-warnin", &ParseContext{Registry: r})
+	test(t, strings.Join(err.(*ParseError).Suggestions, " "), "acme-warning")
+
+	test(t, editDistance("", ""), 0)
+	test(t, editDistance("abc", ""), 3)
+	test(t, editDistance("kitten", "sitting"), 3)
+	test(t, editDistance("fuschia", "fuchsia"), 2)
+	test(t, editDistance("lavednar", "lavendar"), 1)
+}
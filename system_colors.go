@@ -0,0 +1,123 @@
+package csscolorparser
+
+import "strings"
+
+// System colors
+// https://www.w3.org/TR/css-color-4/#css-system-colors
+
+// SystemColors maps lowercase system color keywords, e.g. "canvastext", to
+// their values. System colors depend on the environment, see IsSystemColor.
+type SystemColors map[string]Color
+
+func hexColor(s string) Color {
+	c, _ := parseHex(s[1:])
+	return c
+}
+
+// LightSystemColors are the default system colors for the light color scheme.
+var LightSystemColors = SystemColors{
+	"accentcolor":      hexColor("#0075ff"),
+	"accentcolortext":  hexColor("#ffffff"),
+	"activetext":       hexColor("#ff0000"),
+	"buttonborder":     hexColor("#767676"),
+	"buttonface":       hexColor("#efefef"),
+	"buttontext":       hexColor("#000000"),
+	"canvas":           hexColor("#ffffff"),
+	"canvastext":       hexColor("#000000"),
+	"field":            hexColor("#ffffff"),
+	"fieldtext":        hexColor("#000000"),
+	"graytext":         hexColor("#6d6d6d"),
+	"highlight":        hexColor("#b5d5ff"),
+	"highlighttext":    hexColor("#000000"),
+	"linktext":         hexColor("#0000ee"),
+	"mark":             hexColor("#ffff00"),
+	"marktext":         hexColor("#000000"),
+	"selecteditem":     hexColor("#0075ff"),
+	"selecteditemtext": hexColor("#ffffff"),
+	"visitedtext":      hexColor("#551a8b"),
+}
+
+// DarkSystemColors are the default system colors for the dark color scheme.
+var DarkSystemColors = SystemColors{
+	"accentcolor":      hexColor("#99c8ff"),
+	"accentcolortext":  hexColor("#000000"),
+	"activetext":       hexColor("#ff9e9e"),
+	"buttonborder":     hexColor("#6b6b6b"),
+	"buttonface":       hexColor("#6b6b6b"),
+	"buttontext":       hexColor("#ffffff"),
+	"canvas":           hexColor("#121212"),
+	"canvastext":       hexColor("#ffffff"),
+	"field":            hexColor("#3b3b3b"),
+	"fieldtext":        hexColor("#ffffff"),
+	"graytext":         hexColor("#8e8e8e"),
+	"highlight":        hexColor("#3f638b"),
+	"highlighttext":    hexColor("#ffffff"),
+	"linktext":         hexColor("#9e9eff"),
+	"mark":             hexColor("#ffff00"),
+	"marktext":         hexColor("#000000"),
+	"selecteditem":     hexColor("#99c8ff"),
+	"selecteditemtext": hexColor("#000000"),
+	"visitedtext":      hexColor("#d0adf0"),
+}
+
+// Deprecated CSS2 system colors and the system colors they map to.
+// https://www.w3.org/TR/css-color-4/#deprecated-system-colors
+var deprecatedSystemColors = map[string]string{
+	"activeborder":        "buttonborder",
+	"activecaption":       "canvas",
+	"appworkspace":        "canvas",
+	"background":          "canvas",
+	"buttonhighlight":     "buttonface",
+	"buttonshadow":        "buttonface",
+	"captiontext":         "canvastext",
+	"inactiveborder":      "buttonborder",
+	"inactivecaption":     "canvas",
+	"inactivecaptiontext": "graytext",
+	"infobackground":      "canvas",
+	"infotext":            "canvastext",
+	"menu":                "canvas",
+	"menutext":            "canvastext",
+	"scrollbar":           "canvas",
+	"threeddarkshadow":    "buttonborder",
+	"threedface":          "buttonface",
+	"threedhighlight":     "buttonborder",
+	"threedlightshadow":   "buttonborder",
+	"threedshadow":        "buttonborder",
+	"window":              "canvas",
+	"windowframe":         "buttonborder",
+	"windowtext":          "canvastext",
+}
+
+// IsSystemColor reports whether s is a system color keyword, including the
+// deprecated CSS2 ones. The value of a system color depends on the
+// environment and can be changed with ParseContext.SystemColors.
+func IsSystemColor(s string) bool {
+	s = strings.TrimSpace(strings.ToLower(s))
+	_, ok := LightSystemColors[s]
+	return ok || deprecatedSystemColors[s] != ""
+}
+
+// IsDeprecatedSystemColor reports whether s is a deprecated CSS2 system color keyword.
+func IsDeprecatedSystemColor(s string) bool {
+	return deprecatedSystemColors[strings.TrimSpace(strings.ToLower(s))] != ""
+}
+
+// systemColor resolves a lowercase system color keyword using the context's
+// table first, then the defaults for the active color scheme.
+func (p *parser) systemColor(s string) (Color, bool) {
+	if c, ok := p.ctx.SystemColors[s]; ok {
+		return c, true
+	}
+	if name, ok := deprecatedSystemColors[s]; ok {
+		if c, ok := p.ctx.SystemColors[name]; ok {
+			return c, true
+		}
+		s = name
+	}
+	if p.ctx.ColorScheme == Dark {
+		c, ok := DarkSystemColors[s]
+		return c, ok
+	}
+	c, ok := LightSystemColors[s]
+	return c, ok
+}
@@ -0,0 +1,104 @@
+package csscolorparser
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_SystemColors(t *testing.T) {
+	data := []struct {
+		s   string
+		hex string
+	}{
+		{"Canvas", "#ffffff"},
+		{"CanvasText", "#000000"},
+		{"LinkText", "#0000ee"},
+		{"VisitedText", "#551a8b"},
+		{"ActiveText", "#ff0000"},
+		{"ButtonFace", "#efefef"},
+		{"Mark", "#ffff00"},
+		{"accentcolor", "#0075ff"},
+		{"ActiveBorder", "#767676"},
+		{"ThreeDFace", "#efefef"},
+		{"WindowText", "#000000"},
+		{"rgb(from Canvas r g b / 50%)", "#ffffff80"},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		test(t, c.HexString(), d.hex)
+		testTrue(t, IsSystemColor(d.s) || d.s[0] == 'r')
+	}
+
+	dark := &ParseContext{ColorScheme: Dark}
+	c, err := ParseWithContext("Canvas", dark)
+	test(t, err, nil)
+	test(t, c.HexString(), "#121212")
+	c, err = ParseWithContext("Window", dark)
+	test(t, err, nil)
+	test(t, c.HexString(), "#121212")
+
+	ctx := &ParseContext{
+		ColorScheme: Dark,
+		SystemColors: SystemColors{
+			"canvas":       {1, 0, 0, 1},
+			"activeborder": {0, 0, 1, 1},
+		},
+	}
+	data2 := [][2]string{
+		{"canvas", "#ff0000"},
+		{"window", "#ff0000"},
+		{"activeborder", "#0000ff"},
+		{"windowframe", "#6b6b6b"},
+		{"canvastext", "#ffffff"},
+	}
+	for _, d := range data2 {
+		c, err := ParseWithContext(d[0], ctx)
+		test(t, err, nil)
+		test(t, c.HexString(), d[1])
+	}
+
+	// Each deprecated keyword maps to a system color
+	for name, to := range deprecatedSystemColors {
+		_, ok := LightSystemColors[to]
+		testTrue(t, ok)
+		testTrue(t, IsSystemColor(name))
+		testTrue(t, IsDeprecatedSystemColor(name))
+	}
+	for name := range LightSystemColors {
+		_, ok := DarkSystemColors[name]
+		testTrue(t, ok)
+		testTrue(t, !IsDeprecatedSystemColor(name))
+	}
+
+	testTrue(t, !IsSystemColor("red"))
+	testTrue(t, !IsSystemColor("#fff"))
+	testTrue(t, IsSystemColor(" HighlightText "))
+}
+
+func Test_SystemColorUsed(t *testing.T) {
+	var used []string
+	ctx := &ParseContext{
+		SystemColorUsed: func(name string) { used = append(used, name) },
+		Var: func(name string) (string, bool) {
+			return "Mark", name == "--bg"
+		},
+	}
+	data := [][2]string{
+		{"red", ""},
+		{"Canvas", "canvas"},
+		{"rgb(from Canvas r g b)", "canvas"},
+		{"color-mix(in srgb, Canvas, red)", "canvas"},
+		{"color-mix(in srgb, ButtonFace, window)", "buttonface window"},
+		{"light-dark(red, LinkText)", ""},
+		{"light-dark(ActiveText, red)", "activetext"},
+		{"var(--bg)", "mark"},
+		{"rgb(from color-mix(in srgb, red, var(--bg)) r g b)", "mark"},
+	}
+	for _, d := range data {
+		used = nil
+		_, err := ParseWithContext(d[0], ctx)
+		test(t, err, nil)
+		test(t, strings.Join(used, " "), d[1])
+	}
+}
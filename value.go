@@ -0,0 +1,111 @@
+package csscolorparser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Value is a parsed color in the color space it was written in, with its
+// components before conversion to sRGB: out of gamut components are kept
+// and missing ones are known.
+type Value struct {
+	// Space is the name of the color function without the legacy "a"
+	// suffix, e.g. "rgb", "hsl" or "oklch", or the color space of the
+	// color() function, e.g. "display-p3". "xyz" is "xyz-d65".
+	// Colors not written with one of these functions, like named colors,
+	// hex colors, color-mix() and light-dark(), are in the "rgb" space, or
+	// "srgb" when out of the sRGB gamut, and have no missing components.
+	Space string
+
+	// Components are in the reference ranges of the relative color syntax:
+	// 0..255 for rgb, 0..100 for the percentages of hsl, hwb and hsv and
+	// the lightness of lab and lch, 0..1 for the lightness of oklab and
+	// oklch and for color(). Hues are in degrees. Missing components are 0.
+	Components [3]float64
+
+	Alpha   float64
+	Missing Missing
+}
+
+// ParseValue parses a CSS color string like Parse, but returns the color
+// in the color space it was written in.
+func ParseValue(s string) (Value, error) {
+	return ParseValueWithContext(s, nil)
+}
+
+// ParseValueWithContext is like ParseValue, but resolves currentcolor,
+// var() and light-dark() using ctx, see ParseWithContext.
+func ParseValueWithContext(s string, ctx *ParseContext) (Value, error) {
+	p := newParser(ctx)
+	c, missing, err := p.parse(s)
+	if err != nil {
+		return Value{}, err
+	}
+	if p.value == nil {
+		// The missing components, if any, are those of another color space
+		if c != c.Clamp() {
+			return Value{"srgb", [3]float64{c.R, c.G, c.B}, c.A, 0}, nil
+		}
+		return Value{"rgb", [3]float64{c.R * 255, c.G * 255, c.B * 255}, c.A, 0}, nil
+	}
+	v := *p.value
+	v.Missing = missing
+	return v, nil
+}
+
+// setValue records the value of the outermost color function.
+func (p *parser) setValue(space string, v [3]float64, alpha float64) {
+	if p.depth != 1 {
+		return
+	}
+	if space == "xyz" {
+		space = "xyz-d65"
+	}
+	p.value = &Value{Space: space, Components: v, Alpha: alpha}
+}
+
+// Color converts the value to sRGB. Out of gamut colors are not clamped,
+// except for the color functions that clamp, like hsl().
+func (v Value) Color() Color {
+	c, ok := fromChannels(v.Space, v.Components, v.Alpha)
+	if !ok {
+		return black
+	}
+	return c
+}
+
+// String returns the CSS serialization of the value, using `none` for
+// missing components. Parsing it gives the same value.
+func (v Value) String() string {
+	var b strings.Builder
+	if _, ok := predefinedSpaces[v.Space]; ok {
+		b.WriteString("color(" + v.Space + " ")
+	} else {
+		b.WriteString(v.Space + "(")
+	}
+	for i, x := range v.Components {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		if v.Missing&(Missing0<<uint(i)) != 0 {
+			b.WriteString("none")
+			continue
+		}
+		b.WriteString(formatNumber(x))
+		// Plain numbers are on the 0..1 scale in lenient mode
+		if i > 0 && (v.Space == "hsl" || v.Space == "hwb" || v.Space == "hsv") {
+			b.WriteByte('%')
+		}
+	}
+	if v.Missing&MissingAlpha != 0 {
+		b.WriteString(" / none")
+	} else if v.Alpha < 1 {
+		b.WriteString(" / " + formatNumber(v.Alpha))
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+func formatNumber(x float64) string {
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
@@ -0,0 +1,82 @@
+package csscolorparser
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_ParseValue(t *testing.T) {
+	data := []struct {
+		s       string
+		space   string
+		v       [3]float64
+		alpha   float64
+		missing Missing
+		str     string
+	}{
+		{"red", "rgb", [3]float64{255, 0, 0}, 1, 0, "rgb(255 0 0)"},
+		{"#ff000080", "rgb", [3]float64{255, 0, 0}, 128.0 / 255, 0, "rgb(255 0 0 / 0.5019607843137255)"},
+		{"rgba(255, 128, 0, 50%)", "rgb", [3]float64{255, 128, 0}, 0.5, 0, "rgb(255 128 0 / 0.5)"},
+		{"rgb(300 -20 none)", "rgb", [3]float64{255, 0, 0}, 1, Missing2, "rgb(255 0 none)"},
+		{"hsl(120deg 50% 25%)", "hsl", [3]float64{120, 50, 25}, 1, 0, "hsl(120 50% 25%)"},
+		{"hwb(none 10% 20% / none)", "hwb", [3]float64{0, 10, 20}, 0, Missing0 | MissingAlpha, "hwb(none 10% 20% / none)"},
+		{"hsv(90, 50%, 100%)", "hsv", [3]float64{90, 50, 100}, 1, 0, "hsv(90 50% 100%)"},
+		{"lab(50% 40 -20)", "lab", [3]float64{50, 40, -20}, 1, 0, "lab(50 40 -20)"},
+		{"lch(-10 30 0.5turn)", "lch", [3]float64{0, 30, 180}, 1, 0, "lch(0 30 180)"},
+		{"oklab(0.5 0.1 -0.1 / 0.25)", "oklab", [3]float64{0.5, 0.1, -0.1}, 0.25, 0, "oklab(0.5 0.1 -0.1 / 0.25)"},
+		{"oklch(70% 0.2 150)", "oklch", [3]float64{0.7, 0.2, 150}, 1, 0, "oklch(0.7 0.2 150)"},
+		{"color(display-p3 1 0 0)", "display-p3", [3]float64{1, 0, 0}, 1, 0, "color(display-p3 1 0 0)"},
+		{"color(srgb 1.25 -0.5 none)", "srgb", [3]float64{1.25, -0.5, 0}, 1, Missing2, "color(srgb 1.25 -0.5 none)"},
+		{"color(xyz 0.5 0.5 0.5)", "xyz-d65", [3]float64{0.5, 0.5, 0.5}, 1, 0, "color(xyz-d65 0.5 0.5 0.5)"},
+		{"oklch(from red l c calc(h + 180))", "oklch", [3]float64{}, 1, 0, ""},
+		{"color-mix(in srgb, red, blue)", "rgb", [3]float64{}, 1, 0, ""},
+		{"color-mix(in oklch, red, blue)", "srgb", [3]float64{}, 1, 0, ""},
+		// The missing hue of the mixed colors is not a missing component
+		{"color-mix(in hsl, white, black)", "rgb", [3]float64{127.5, 127.5, 127.5}, 1, 0, "rgb(127.5 127.5 127.5)"},
+		{"light-dark(oklch(0.5 0.1 none), red)", "rgb", [3]float64{}, 1, 0, ""},
+	}
+	for _, d := range data {
+		v, err := ParseValue(d.s)
+		test(t, err, nil)
+		test(t, v.Space, d.space)
+		test(t, v.Missing, d.missing)
+		if math.Abs(v.Alpha-d.alpha) > 1e-9 {
+			t.Errorf("%s: alpha %v", d.s, v.Alpha)
+		}
+		if d.str != "" {
+			test(t, v.String(), d.str)
+			for i := range v.Components {
+				if math.Abs(v.Components[i]-d.v[i]) > 1e-9 {
+					t.Errorf("%s: got %v", d.s, v.Components)
+					break
+				}
+			}
+		}
+
+		// Same color as Parse, and the serialization parses to the same value
+		c, _ := Parse(d.s)
+		testColor(t, v.Color().Clamp(), c.Clamp())
+		w, err := ParseValue(v.String())
+		test(t, err, nil)
+		test(t, w.Space, v.Space)
+		test(t, w.Missing, v.Missing)
+		for i := range v.Components {
+			if math.Abs(w.Components[i]-v.Components[i]) > 1e-9 {
+				t.Errorf("%s: %v round trips to %v", d.s, v, w)
+				break
+			}
+		}
+	}
+
+	// Out of gamut components are kept
+	v, _ := ParseValue("color(display-p3 0 1 0)")
+	c := v.Color()
+	testTrue(t, c.R < 0 && c.G > 1)
+
+	// Nested colors don't leak into the value
+	v, _ = ParseValueWithContext("light-dark(oklch(0.5 0.1 10), hsl(0 0% 0%))", &ParseContext{ColorScheme: Light})
+	test(t, v.Space, "rgb")
+
+	_, err := ParseValue("oklch(1 2)")
+	testTrue(t, err != nil)
+}
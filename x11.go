@@ -0,0 +1,141 @@
+package csscolorparser
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// X11 color specifications, as used by XParseColor and xterm
+// https://www.x.org/releases/current/doc/libX11/libX11/libX11.html#Color_Strings
+
+// X11String returns the color in the X11 "rgb:r/g/b" form, with digits hex
+// digits (1 to 4) per channel, ignoring alpha. X11String(4) is the form
+// reported by xterm, e.g. "rgb:ffff/8080/0000".
+func (c Color) X11String(digits int) string {
+	if digits < 1 {
+		digits = 1
+	} else if digits > 4 {
+		digits = 4
+	}
+	max := float64(uint(1)<<(4*uint(digits)) - 1)
+	c = c.Clamp()
+	return fmt.Sprintf("rgb:%0*x/%0*x/%0*x",
+		digits, int(math.Round(c.R*max)),
+		digits, int(math.Round(c.G*max)),
+		digits, int(math.Round(c.B*max)))
+}
+
+// X11IntensityString returns the color in the X11 "rgbi:r/g/b" form, with
+// intensities in 0..1, ignoring alpha, e.g. "rgbi:1/0.5/0".
+func (c Color) X11IntensityString() string {
+	c = c.Clamp()
+	return "rgbi:" + formatNumber(c.R) + "/" + formatNumber(c.G) + "/" + formatNumber(c.B)
+}
+
+// X11HexString returns the color in the X11 #RRRGGGBBB (digits = 3) or
+// #RRRRGGGGBBBB (digits = 4) form, ignoring alpha. The digits are the most
+// significant bits of 16-bit channels, see parseLongHex.
+func (c Color) X11HexString(digits int) string {
+	if digits < 3 {
+		digits = 3
+	} else if digits > 4 {
+		digits = 4
+	}
+	shift := float64(uint(1) << (16 - 4*uint(digits)))
+	max := float64(uint(1)<<(4*uint(digits)) - 1)
+	ch := func(v float64) int {
+		return int(math.Min(math.Round(v*0xffff/shift), max))
+	}
+	c = c.Clamp()
+	return fmt.Sprintf("#%0*x%0*x%0*x", digits, ch(c.R), digits, ch(c.G), digits, ch(c.B))
+}
+
+// x11Color parses the rgb:, rgbi: and CIEXYZ: forms. It returns false if s
+// is not one of them.
+func (p *parser) x11Color(s string, offset int) (Color, bool, error) {
+	i := strings.IndexByte(s, ':')
+	if i == -1 {
+		return black, false, nil
+	}
+	prefix := s[:i]
+	if prefix != "rgb" && prefix != "rgbi" && prefix != "ciexyz" {
+		return black, false, nil
+	}
+	if p.strict() {
+		return black, true, p.failf(ErrInvalidFormat, offset, s, "X11 color specifications are not CSS")
+	}
+
+	parts := strings.Split(s[i+1:], "/")
+	if len(parts) != 3 {
+		return black, true, p.failf(ErrWrongArity, offset, s, "expected 3 components, got %d", len(parts))
+	}
+	var v [3]float64
+	pos := offset + i + 1
+	for j, part := range parts {
+		var ok bool
+		if prefix == "rgb" {
+			v[j], ok = parseX11Hex(part)
+			if !ok {
+				if len(part) >= 1 && len(part) <= 4 {
+					return black, true, p.fail(ErrBadHexDigit, pos, part)
+				}
+				return black, true, p.failf(ErrBadHexLength, pos, part, "expected 1 to 4 digits, got %d", len(part))
+			}
+		} else {
+			v[j], ok = parseFloat(part)
+			if !ok || part != strings.TrimSpace(part) {
+				return black, true, p.fail(ErrBadNumber, pos, part)
+			}
+			if prefix == "rgbi" && (v[j] < 0 || v[j] > 1) {
+				return black, true, p.failf(ErrOutOfRange, pos, part, "intensity must be between 0 and 1")
+			}
+		}
+		pos += len(part) + 1
+	}
+
+	if prefix == "ciexyz" {
+		c, _ := fromPredefined("xyz-d65", v[0], v[1], v[2], 1)
+		return c, true, nil
+	}
+	return Color{v[0], v[1], v[2], 1}, true, nil
+}
+
+// parseX11Hex parses a channel of 1 to 4 hex digits, scaled to its bit
+// depth: "f", "ff", "fff" and "ffff" are all 1.
+func parseX11Hex(s string) (float64, bool) {
+	if len(s) < 1 || len(s) > 4 {
+		return 0, false
+	}
+	var v uint
+	for i := 0; i < len(s); i++ {
+		if !isHex(s[i]) {
+			return 0, false
+		}
+		v = v<<4 | uint(hexValue(s[i]))
+	}
+	return float64(v) / float64(uint(1)<<(4*uint(len(s)))-1), true
+}
+
+// parseLongHex parses the X11 #RRRGGGBBB and #RRRRGGGGBBBB forms. Unlike
+// rgb:, the digits are the most significant bits of a 16-bit channel, as
+// XParseColor does: "fff" is 0xfff0, not 0xffff.
+func parseLongHex(s string) (c Color, ok bool) {
+	if len(s) != 9 && len(s) != 12 {
+		return black, false
+	}
+	n := len(s) / 3
+	var v [3]float64
+	for i := range v {
+		var x uint
+		for _, d := range []byte(s[i*n : (i+1)*n]) {
+			if !isHex(d) {
+				return black, false
+			}
+			x = x<<4 | uint(hexValue(d))
+		}
+		x <<= 16 - 4*uint(n)
+		v[i] = float64(x) / 0xffff
+	}
+	return Color{v[0], v[1], v[2], 1}, true
+}
@@ -0,0 +1,102 @@
+package csscolorparser
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func Test_X11(t *testing.T) {
+	data := []struct {
+		s   string
+		rgb [3]float64
+	}{
+		{"rgb:f/8/0", [3]float64{1, 8.0 / 15, 0}},
+		{"rgb:ff/80/00", [3]float64{1, 128.0 / 255, 0}},
+		{"rgb:ffff/8000/0000", [3]float64{1, 32768.0 / 65535, 0}},
+		{"RGB:fff/80/0", [3]float64{1, 128.0 / 255, 0}},
+		{"rgbi:1.0/0.5/0.0", [3]float64{1, 0.5, 0}},
+		{"rgbi:1/.25/0", [3]float64{1, 0.25, 0}},
+		{"CIEXYZ:0.95045592705/1.0/1.08905775076", [3]float64{1, 1, 1}},
+		{"#fff800000", [3]float64{0xfff0 / 65535.0, 0x8000 / 65535.0, 0}},
+		{"#ffff80000000", [3]float64{1, 0x8000 / 65535.0, 0}},
+	}
+	for _, d := range data {
+		c, err := Parse(d.s)
+		test(t, err, nil)
+		for i, v := range [3]float64{c.R, c.G, c.B} {
+			if math.Abs(v-d.rgb[i]) > 1e-9 {
+				t.Errorf("%q: channel %d: expected %v, got %v", d.s, i, d.rgb[i], v)
+			}
+		}
+		test(t, c.A, 1.0)
+	}
+
+	c, _ := Parse("rgb:ff/80/00")
+	test(t, c.X11String(2), "rgb:ff/80/00")
+	test(t, c.X11String(4), "rgb:ffff/8080/0000")
+	test(t, c.X11String(1), "rgb:f/8/0")
+	test(t, c.X11String(9), "rgb:ffff/8080/0000")
+	c, _ = Parse(Color{0.2, 0.4, 0.6, 0.5}.X11String(3))
+	test(t, c.HexString(), "#336699")
+
+	// Round trips of the other forms
+	for _, c := range []Color{{1, 0.5, 0, 1}, {0.2, 0.4, 0.6, 1}, {0, 0, 0, 1}, {1, 1, 1, 1}} {
+		d, err := Parse(c.X11IntensityString())
+		test(t, err, nil)
+		test(t, d, c)
+		for digits, tolerance := range map[int]float64{3: 16.0 / 0xffff, 4: 0.5 / 0xffff} {
+			d, err := Parse(c.X11HexString(digits))
+			test(t, err, nil)
+			for i, v := range [3]float64{d.R - c.R, d.G - c.G, d.B - c.B} {
+				if math.Abs(v) > tolerance+1e-12 {
+					t.Errorf("%v: %s: channel %d is off by %v", c, c.X11HexString(digits), i, v)
+				}
+			}
+		}
+	}
+	test(t, Color{1, 0.5, 0, 0.5}.X11IntensityString(), "rgbi:1/0.5/0")
+	test(t, Color{2, -1, 0, 1}.X11IntensityString(), "rgbi:1/0/0")
+	c, _ = Parse("#fff800000")
+	test(t, c.X11HexString(3), "#fff800000")
+	test(t, c.X11HexString(4), "#fff080000000")
+	test(t, c.X11HexString(1), "#fff800000")
+	c, _ = Parse("#ffff80000000")
+	test(t, c.X11HexString(4), "#ffff80000000")
+	test(t, c.X11HexString(3), "#fff800000")
+
+	invalid := []struct {
+		s      string
+		kind   error
+		offset int
+		token  string
+	}{
+		{"rgb:ff/80", ErrWrongArity, 0, "rgb:ff/80"},
+		{"rgb:ff/80/00/00", ErrWrongArity, 0, "rgb:ff/80/00/00"},
+		{"rgb:ff/8g/00", ErrBadHexDigit, 7, "8g"},
+		{"rgb:ff/80/", ErrBadHexLength, 10, ""},
+		{"rgb:fffff/80/00", ErrBadHexLength, 4, "fffff"},
+		{"rgbi:1/0.5/x", ErrBadNumber, 11, "x"},
+		{"rgbi:1/1.5/0", ErrOutOfRange, 7, "1.5"},
+		{"ciexyz:1/1", ErrWrongArity, 0, "ciexyz:1/1"},
+		{"#ff00000000", ErrBadHexLength, 0, "#ff00000000"},
+		{"#fff80000g", ErrBadHexDigit, 9, "g"},
+	}
+	for _, d := range invalid {
+		_, err := Parse(d.s)
+		var e *ParseError
+		if !errors.As(err, &e) {
+			t.Errorf("%q: expected a ParseError, got %v", d.s, err)
+			continue
+		}
+		testTrue(t, errors.Is(err, d.kind))
+		test(t, e.Offset, d.offset)
+		test(t, e.Token, d.token)
+	}
+
+	strict := &ParseContext{Strict: true}
+	for _, s := range []string{"rgb:ff/80/00", "rgbi:1/0.5/0", "#fff800000", "#ffff80000000"} {
+		_, err := ParseWithContext(s, strict)
+		testTrue(t, err != nil)
+	}
+}
@@ -0,0 +1,152 @@
+package csscolorparser
+
+import "math"
+
+// CIE XYZ and chromaticity coordinates
+// https://www.w3.org/TR/css-color-4/#predefined-xyz
+
+// FromXYZ creates a Color from CIE XYZ colors relative to the D65 white
+// point, like color(xyz-d65). Y is the relative luminance, 1 for white.
+func FromXYZ(x, y, z, alpha float64) Color {
+	R, G, B := xyz65ToLinearSrgb.mul(x, y, z)
+	// Out of gamut, keep the sign of negative channels like color(xyz-d65)
+	return Color{fromLinearExtended(R), fromLinearExtended(G), fromLinearExtended(B), clamp0_1(alpha)}
+}
+
+// FromXYZD50 creates a Color from CIE XYZ colors relative to the D50 white
+// point, like color(xyz-d50), using Bradford chromatic adaptation.
+func FromXYZD50(x, y, z, alpha float64) Color {
+	x, y, z = xyz50ToXyz65.mul(x, y, z)
+	return FromXYZ(x, y, z, alpha)
+}
+
+// ToXYZ returns the CIE XYZ components of the color relative to the D65
+// white point, the inverse of FromXYZ.
+func (c Color) ToXYZ() (x, y, z, alpha float64) {
+	x, y, z = linearSrgbToXyz65.mul(toLinear(c.R), toLinear(c.G), toLinear(c.B))
+	return x, y, z, c.A
+}
+
+// ToXYZD50 returns the CIE XYZ components of the color relative to the D50
+// white point, the inverse of FromXYZD50.
+func (c Color) ToXYZD50() (x, y, z, alpha float64) {
+	x, y, z, alpha = c.ToXYZ()
+	x, y, z = xyz65ToXyz50.mul(x, y, z)
+	return x, y, z, alpha
+}
+
+// D65 white point chromaticity
+var d65White = [2]float64{0.3127, 0.3290}
+
+// FromXyY creates a Color from CIE xyY colors: the chromaticity x, y and
+// the luminance Y, relative to the D65 white point.
+func FromXyY(x, y, Y, alpha float64) Color {
+	if y == 0 {
+		return FromXYZ(0, 0, 0, alpha)
+	}
+	return FromXYZ(x*Y/y, Y, (1-x-y)*Y/y, alpha)
+}
+
+// ToXyY returns the CIE xyY components of the color, the inverse of
+// FromXyY. The chromaticity of black is the one of the white point.
+func (c Color) ToXyY() (x, y, Y, alpha float64) {
+	X, Y, Z, alpha := c.ToXYZ()
+	sum := X + Y + Z
+	if sum == 0 {
+		return d65White[0], d65White[1], 0, alpha
+	}
+	return X / sum, Y / sum, Y, alpha
+}
+
+// ToUV returns the CIE 1976 UCS chromaticity coordinates u′, v′ of the
+// color. The chromaticity of black is the one of the white point.
+func (c Color) ToUV() (u, v float64) {
+	x, y, _, _ := c.ToXyY()
+	d := -2*x + 12*y + 3
+	return 4 * x / d, 9 * y / d
+}
+
+// Chromaticity of the spectral locus of the CIE 1931 2° standard observer,
+// from 380 to 700 nm in steps of 5 nm. Beyond 700 nm it doesn't change.
+var spectralLocus = [...][2]float64{
+	{0.1741, 0.0050}, {0.1740, 0.0050}, {0.1738, 0.0049}, {0.1736, 0.0049},
+	{0.1733, 0.0048}, {0.1730, 0.0048}, {0.1726, 0.0048}, {0.1721, 0.0048},
+	{0.1714, 0.0051}, {0.1703, 0.0058}, {0.1689, 0.0069}, {0.1669, 0.0086},
+	{0.1644, 0.0109}, {0.1611, 0.0138}, {0.1566, 0.0177}, {0.1510, 0.0227},
+	{0.1440, 0.0297}, {0.1355, 0.0399}, {0.1241, 0.0578}, {0.1096, 0.0868},
+	{0.0913, 0.1327}, {0.0687, 0.2007}, {0.0454, 0.2950}, {0.0235, 0.4127},
+	{0.0082, 0.5384}, {0.0039, 0.6548}, {0.0139, 0.7502}, {0.0389, 0.8120},
+	{0.0743, 0.8338}, {0.1142, 0.8262}, {0.1547, 0.8059}, {0.1929, 0.7816},
+	{0.2296, 0.7543}, {0.2658, 0.7243}, {0.3016, 0.6923}, {0.3373, 0.6589},
+	{0.3731, 0.6245}, {0.4087, 0.5896}, {0.4441, 0.5547}, {0.4788, 0.5202},
+	{0.5125, 0.4866}, {0.5448, 0.4544}, {0.5752, 0.4242}, {0.6029, 0.3965},
+	{0.6270, 0.3725}, {0.6482, 0.3514}, {0.6658, 0.3340}, {0.6801, 0.3197},
+	{0.6915, 0.3083}, {0.7006, 0.2993}, {0.7079, 0.2920}, {0.7140, 0.2859},
+	{0.7190, 0.2809}, {0.7230, 0.2770}, {0.7260, 0.2740}, {0.7283, 0.2717},
+	{0.7300, 0.2700}, {0.7311, 0.2689}, {0.7320, 0.2680}, {0.7327, 0.2673},
+	{0.7334, 0.2666}, {0.7340, 0.2660}, {0.7344, 0.2656}, {0.7346, 0.2654},
+	{0.7347, 0.2653},
+}
+
+const (
+	locusStart = 380.0
+	locusStep  = 5.0
+)
+
+// DominantWavelength returns the dominant wavelength in nanometers of the
+// color and its excitation purity [0..1], relative to the D65 white point.
+// Purples have no dominant wavelength: for them the wavelength is the
+// negated complementary wavelength. Grays have a wavelength of 0.
+func (c Color) DominantWavelength() (wavelength, purity float64) {
+	x, y, _, _ := c.ToXyY()
+	dx, dy := x-d65White[0], y-d65White[1]
+	dist := math.Hypot(dx, dy)
+	if dist < 1e-9 {
+		return 0, 0
+	}
+
+	// Intersect the ray from the white point through the color with the
+	// spectral locus, then with the opposite ray.
+	if wl, t, ok := intersectLocus(dx, dy); ok {
+		return wl, math.Min(dist/t, 1)
+	}
+	wl, _, ok := intersectLocus(-dx, -dy)
+	if !ok {
+		return 0, 0
+	}
+	// Purity is measured against the line of purples.
+	first, last := spectralLocus[0], spectralLocus[len(spectralLocus)-1]
+	t, _, _ := intersectSegment(d65White, dx/dist, dy/dist, first, last)
+	return -wl, math.Min(dist/t, 1)
+}
+
+// intersectLocus returns the wavelength where the ray from the white point
+// in direction dx, dy crosses the spectral locus, and the distance to it.
+func intersectLocus(dx, dy float64) (wavelength, dist float64, ok bool) {
+	norm := math.Hypot(dx, dy)
+	dx, dy = dx/norm, dy/norm
+	for i := 0; i+1 < len(spectralLocus); i++ {
+		t, u, hit := intersectSegment(d65White, dx, dy, spectralLocus[i], spectralLocus[i+1])
+		if hit {
+			return locusStart + (float64(i)+u)*locusStep, t, true
+		}
+	}
+	return 0, 0, false
+}
+
+// intersectSegment intersects the ray o + t*(dx, dy), t > 0, with the
+// segment from a to b, at a + u*(b - a). The direction must be normalized
+// for t to be a distance.
+func intersectSegment(o [2]float64, dx, dy float64, a, b [2]float64) (t, u float64, ok bool) {
+	ex, ey := b[0]-a[0], b[1]-a[1]
+	den := dx*ey - dy*ex
+	if den == 0 {
+		return 0, 0, false
+	}
+	wx, wy := a[0]-o[0], a[1]-o[1]
+	t = (wx*ey - wy*ex) / den
+	u = (wx*dy - wy*dx) / den
+	// Rays through a vertex of the locus must hit one of its segments
+	const eps = 1e-12
+	return t, u, t > 0 && u >= -eps && u <= 1+eps
+}
@@ -0,0 +1,114 @@
+package csscolorparser
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func Test_XYZ(t *testing.T) {
+	near := func(a, b, tolerance float64) bool {
+		return math.Abs(a-b) < tolerance
+	}
+
+	// White points
+	x, y, z, alpha := Color{1, 1, 1, 0.5}.ToXYZ()
+	testTrue(t, near(x, 0.3127/0.3290, 1e-9) && near(y, 1, 1e-9) && near(z, (1-0.3127-0.3290)/0.3290, 1e-9))
+	test(t, alpha, 0.5)
+	x, y, z, _ = Color{1, 1, 1, 1}.ToXYZD50()
+	testTrue(t, near(x, 0.3457/0.3585, 1e-6) && near(y, 1, 1e-6) && near(z, (1-0.3457-0.3585)/0.3585, 1e-6))
+
+	// Y is the relative luminance
+	c := Color{0.2, 0.5, 0.8, 1}
+	_, y, _, _ = c.ToXYZ()
+	testTrue(t, near(y, c.Luminance(), 1e-4))
+
+	a, _ := Parse("color(xyz-d65 0.25 0.3 0.35)")
+	b := FromXYZ(0.25, 0.3, 0.35, 1)
+	testTrue(t, near(a.R, b.R, 1e-9) && near(a.G, b.G, 1e-9) && near(a.B, b.B, 1e-9))
+	a, _ = Parse("color(xyz-d50 0.25 0.3 0.25)")
+	b = FromXYZD50(0.25, 0.3, 0.25, 1)
+	testTrue(t, near(a.R, b.R, 1e-9) && near(a.G, b.G, 1e-9) && near(a.B, b.B, 1e-9))
+
+	// Out of gamut, negative channels keep their sign
+	p3, _ := Parse("color(display-p3 1 0 0)")
+	testTrue(t, p3.G < -0.2)
+	x, y, z, _ = p3.ToXYZ()
+	a, _ = Parse(fmt.Sprintf("color(xyz-d65 %v %v %v)", x, y, z))
+	for _, b := range []Color{FromXYZ(p3.ToXYZ()), FromXYZD50(p3.ToXYZD50()), FromXyY(p3.ToXyY()), a} {
+		testTrue(t, near(b.R, p3.R, 1e-9) && near(b.G, p3.G, 1e-9) && near(b.B, p3.B, 1e-9))
+	}
+
+	// sRGB primaries
+	data := []struct {
+		c          Color
+		x, y, u, v float64
+	}{
+		{Color{1, 0, 0, 1}, 0.64, 0.33, 0.4507, 0.5229},
+		{Color{0, 1, 0, 1}, 0.30, 0.60, 0.1250, 0.5625},
+		{Color{0, 0, 1, 1}, 0.15, 0.06, 0.1754, 0.1579},
+		{Color{0.6, 0.6, 0.6, 1}, 0.3127, 0.3290, 0.1978, 0.4683},
+		{Color{0, 0, 0, 1}, 0.3127, 0.3290, 0.1978, 0.4683},
+	}
+	for _, d := range data {
+		x, y, Y, _ := d.c.ToXyY()
+		u, v := d.c.ToUV()
+		if !near(x, d.x, 1e-4) || !near(y, d.y, 1e-4) || !near(u, d.u, 1e-4) || !near(v, d.v, 1e-4) {
+			t.Errorf("%v: xy %v %v, u′v′ %v %v", d.c, x, y, u, v)
+		}
+		testColor(t, FromXyY(x, y, Y, 1), d.c)
+	}
+
+	// Round trip
+	for _, c := range []Color{{0.2, 0.4, 0.6, 1}, {1, 0.5, 0, 0.25}, {0.01, 0, 0.02, 1}} {
+		for _, x := range []Color{FromXYZ(c.ToXYZ()), FromXYZD50(c.ToXYZD50()), FromXyY(c.ToXyY())} {
+			if !near(x.R, c.R, 1e-9) || !near(x.G, c.G, 1e-9) || !near(x.B, c.B, 1e-9) || x.A != c.A {
+				t.Errorf("%v: round trip gives %v", c, x)
+			}
+		}
+	}
+}
+
+func Test_DominantWavelength(t *testing.T) {
+	data := []struct {
+		s                  string
+		wavelength, purity float64
+	}{
+		{"red", 611.4, 0.917},
+		{"lime", 549.1, 0.735},
+		{"blue", 464.2, 0.925},
+		{"yellow", 570.5, 0.792},
+		{"orange", 583.8, 0.838},
+		{"magenta", -549.1, 0.688},
+		{"white", 0, 0},
+		{"gray", 0, 0},
+		{"black", 0, 0},
+	}
+	for _, d := range data {
+		c, _ := Parse(d.s)
+		wl, p := c.DominantWavelength()
+		if math.Abs(wl-d.wavelength) > 0.1 || math.Abs(p-d.purity) > 1e-3 {
+			t.Errorf("%s: got %v nm, %v", d.s, wl, p)
+		}
+	}
+
+	// Cyan region, where the locus curves sharply: halfway between the
+	// white point and the CIE 1931 chromaticity at 495 and 505 nm
+	for wl, xy := range map[float64][2]float64{495: {0.0235, 0.4127}, 505: {0.0039, 0.6548}} {
+		x, y := (xy[0]+d65White[0])/2, (xy[1]+d65White[1])/2
+		got, p := FromXyY(x, y, 0.3, 1).DominantWavelength()
+		if math.Abs(got-wl) > 0.01 || math.Abs(p-0.5) > 1e-6 {
+			t.Errorf("%v nm: got %v nm, %v", wl, got, p)
+		}
+	}
+
+	// The locus itself
+	for i := 1; i < len(spectralLocus)-1; i++ {
+		xy := spectralLocus[i]
+		dx, dy := xy[0]-d65White[0], xy[1]-d65White[1]
+		wl, dist, ok := intersectLocus(dx, dy)
+		if !ok || math.Abs(wl-(locusStart+locusStep*float64(i))) > 1e-6 || math.Abs(dist-math.Hypot(dx, dy)) > 1e-9 {
+			t.Errorf("%v nm: got %v nm at %v", locusStart+locusStep*float64(i), wl, dist)
+		}
+	}
+}